@@ -15,6 +15,7 @@ type Analysis struct {
 	defaultAnalyzer Analyzer
 	analyzer        []Analyzer
 	normalizer      []Normalizer
+	tokenizer       []Tokenizer
 	filter          []TokenFilter
 	charFilter      []CharacterFilter
 }
@@ -42,6 +43,12 @@ func (a *Analysis) Normalizer(normalizer ...Normalizer) *Analysis {
 	return a
 }
 
+// Tokenizer sets the tokenizers for this index text analysis.
+func (a *Analysis) Tokenizer(tokenizer ...Tokenizer) *Analysis {
+	a.tokenizer = append(a.tokenizer, tokenizer...)
+	return a
+}
+
 // Filter sets the token filters for this index text analysis.
 func (a *Analysis) Filter(filter ...TokenFilter) *Analysis {
 	a.filter = append(a.filter, filter...)
@@ -56,7 +63,7 @@ func (a *Analysis) CharFilter(charFilter ...CharacterFilter) *Analysis {
 
 // Validate validates Analysis.
 func (a *Analysis) Validate() error {
-	return nil
+	return a.ValidateNormalizers()
 }
 
 // Source returns the serializable JSON for the source builder.
@@ -78,6 +85,11 @@ func (a *Analysis) Source(includeName bool) (interface{}, error) {
 	// 				"char_filter": ["quote"]
 	// 			}
 	// 		},
+	// 		"tokenizer": {
+	// 			"custom_icu": {
+	// 				"type": "icu_tokenizer"
+	// 			}
+	// 		},
 	// 		"filter": {
 	// 			"custom_synonym": {
 	// 				"type": "synonym",
@@ -125,6 +137,17 @@ func (a *Analysis) Source(includeName bool) (interface{}, error) {
 		}
 		options["normalizer"] = normalizers
 	}
+	if len(a.tokenizer) > 0 {
+		tokenizers := make(map[string]interface{})
+		for _, t := range a.tokenizer {
+			tokenizer, err := t.Source(false)
+			if err != nil {
+				return nil, err
+			}
+			tokenizers[t.Name()] = tokenizer
+		}
+		options["tokenizer"] = tokenizers
+	}
 	if len(a.filter) > 0 {
 		filters := make(map[string]interface{})
 		for _, f := range a.filter {
@@ -0,0 +1,376 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// relativeBundlePath returns whether p is a relative path which stays inside the directory it
+// is relative to.
+func relativeBundlePath(p string) bool {
+	if p == "" || path.IsAbs(p) || strings.Contains(p, "\\") {
+		return false
+	}
+	cleaned := path.Clean(p)
+	return cleaned != ".." && !strings.HasPrefix(cleaned, "../")
+}
+
+// AnalysisBundleFile file referenced by an analysis component through one of its `*_path`
+// options. The path is relative to the Elasticsearch `config` directory.
+type AnalysisBundleFile struct {
+	// Path path of the file, relative to the Elasticsearch `config` directory.
+	Path string
+	// Component name of the analysis component referencing the file.
+	Component string
+	// Option option of the component referencing the file, eg "synonyms_path".
+	Option string
+	// Content content of the file, nil when the file is not generated by the bundle and must
+	// be provided separately, eg hunspell dictionaries.
+	Content []byte
+}
+
+// External returns whether the file must be provided separately.
+func (f *AnalysisBundleFile) External() bool {
+	return f.Content == nil
+}
+
+// AnalysisBundleManifest manifest of the files an Analysis depends on.
+type AnalysisBundleManifest struct {
+	Files []*AnalysisBundleFile
+}
+
+// Write writes the generated files into the Elasticsearch `config` directory, together with
+// a `manifest.json` file in the bundle directory. Files outside of the `config` directory are
+// rejected.
+func (m *AnalysisBundleManifest) Write(configDir, dir string) error {
+	for _, f := range m.Files {
+		if f.External() {
+			continue
+		}
+		if !relativeBundlePath(f.Path) {
+			return fmt.Errorf("bundle file %s of component [%s] is outside of the config directory", f.Path, f.Component)
+		}
+		filename := filepath.Join(configDir, filepath.FromSlash(f.Path))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filename, f.Content, 0644); err != nil {
+			return err
+		}
+	}
+	src, err := m.Source()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(src, "", "  ")
+	if err != nil {
+		return err
+	}
+	filename := filepath.Join(configDir, filepath.FromSlash(dir), "manifest.json")
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, data, 0644)
+}
+
+// Source returns the serializable JSON for the source builder.
+func (m *AnalysisBundleManifest) Source() (interface{}, error) {
+	// {
+	// 	"files": [
+	// 		{
+	// 			"path": "analysis/filter/my_synonyms_synonyms.txt",
+	// 			"component": "my_synonyms",
+	// 			"option": "synonyms_path",
+	// 			"size": 42,
+	// 			"sha256": "..."
+	// 		},
+	// 		{
+	// 			"path": "hunspell/en_US",
+	// 			"component": "en_US",
+	// 			"option": "locale",
+	// 			"external": true
+	// 		}
+	// 	]
+	// }
+	files := make([]interface{}, 0, len(m.Files))
+	for _, f := range m.Files {
+		file := make(map[string]interface{})
+		file["path"] = f.Path
+		file["component"] = f.Component
+		file["option"] = f.Option
+		if f.External() {
+			file["external"] = true
+		} else {
+			sum := sha256.Sum256(f.Content)
+			file["size"] = len(f.Content)
+			file["sha256"] = hex.EncodeToString(sum[:])
+		}
+		files = append(files, file)
+	}
+	source := make(map[string]interface{})
+	source["files"] = files
+	return source, nil
+}
+
+// AnalysisBundle exporter that moves large inline lists of analysis components, such as
+// synonyms, stop words and keep words, into files laid out relative to the Elasticsearch
+// `config` directory, and rewrites the components to use the matching `*_path` options.
+type AnalysisBundle struct {
+	dir     string
+	minSize int
+}
+
+// NewAnalysisBundle initializes a new AnalysisBundle which exports files into dir, relative
+// to the Elasticsearch `config` directory, eg "analysis".
+func NewAnalysisBundle(dir string) *AnalysisBundle {
+	return &AnalysisBundle{
+		dir:     dir,
+		minSize: 1,
+	}
+}
+
+// MinSize sets the minimum number of entries an inline list must have to be exported.
+// Defaults to 1.
+func (b *AnalysisBundle) MinSize(minSize int) *AnalysisBundle {
+	b.minSize = minSize
+	return b
+}
+
+// Export exports the inline lists of the Analysis components into files and rewrites the
+// components in place to reference them. Files are laid out by component kind, eg
+// "analysis/filter/my_synonyms_synonyms.txt", and components whose names are not valid file
+// names, eg containing `/` or `..`, are rejected. Files which are already referenced through `*_path`
+// options, hyphenation patterns and hunspell dictionaries are listed as external files.
+// Stop words lists containing pre-defined lists like "_english_" are left inline. The
+// components are only rewritten when the export succeeds, the Analysis is left unchanged
+// otherwise.
+func (b *AnalysisBundle) Export(a *Analysis) (*AnalysisBundleManifest, error) {
+	m := &AnalysisBundleManifest{
+		Files: make([]*AnalysisBundleFile, 0),
+	}
+	var (
+		seen  = make(map[string]string)
+		err   error
+		apply []func()
+	)
+	// export moves lines into a file of the group of components when the list is large enough,
+	// returning the new path.
+	export := func(group, component, kind string, lines []string) string {
+		if len(lines) == 0 || len(lines) < b.minSize || err != nil {
+			return ""
+		}
+		if strings.ContainsAny(component, "/\\") || strings.Contains(component, "..") {
+			err = fmt.Errorf("invalid bundle file name for %s [%s]", group, component)
+			return ""
+		}
+		p := path.Join(b.dir, group, component+"_"+kind+".txt")
+		if other, exists := seen[p]; exists {
+			err = fmt.Errorf("conflicting bundle file %s for components [%s %s]", p, other, component)
+			return ""
+		}
+		seen[p] = component
+		m.Files = append(m.Files, &AnalysisBundleFile{
+			Path:      p,
+			Component: component,
+			Option:    kind + "_path",
+			Content:   []byte(strings.Join(lines, "\n") + "\n"),
+		})
+		return p
+	}
+	// external lists a file which must be provided separately.
+	external := func(component, option, p string) {
+		if p == "" {
+			return
+		}
+		m.Files = append(m.Files, &AnalysisBundleFile{
+			Path:      p,
+			Component: component,
+			Option:    option,
+		})
+	}
+	// rewrite defers rewriting a component to reference its exported file until the export
+	// succeeds.
+	rewrite := func(fn func()) {
+		apply = append(apply, fn)
+	}
+	// ruleLines returns the lines of mapping rules, failing the export on invalid rules.
+	ruleLines := func(group, component string, rules []*MappingRule, rawRules []string) []string {
+		lines, rerr := mappingRuleLines(rules, rawRules)
+		if rerr != nil && err == nil {
+			err = fmt.Errorf("%s [%s]: %v", group, component, rerr)
+		}
+		return lines
+	}
+	// stopwords exports literal stop words lists only.
+	stopwords := func(group, component string, words *[]string, stopwordsPath *string) {
+		for _, w := range *words {
+			if isStopwordsName(w) {
+				return
+			}
+		}
+		if p := export(group, component, "stopwords", *words); p != "" {
+			rewrite(func() { *words, *stopwordsPath = make([]string, 0), p })
+			return
+		}
+		external(component, "stopwords_path", *stopwordsPath)
+	}
+
+	// analyzer exports the stop words of an analyzer, named "default" for the default analyzer.
+	analyzer := func(name string, _a Analyzer) {
+		switch t := _a.(type) {
+		case *AnalyzerStop:
+			stopwords("analyzer", name, &t.stopwords, &t.stopwordsPath)
+		case *AnalyzerStandard:
+			stopwords("analyzer", name, &t.stopwords, &t.stopwordsPath)
+		case *AnalyzerLanguage:
+			stopwords("analyzer", name, &t.stopwords, &t.stopwordsPath)
+		}
+	}
+	if a.defaultAnalyzer != nil {
+		analyzer("default", a.defaultAnalyzer)
+	}
+	for _, _a := range a.analyzer {
+		if _a != a.defaultAnalyzer {
+			analyzer(_a.Name(), _a)
+		}
+	}
+	for _, f := range a.filter {
+		name := f.Name()
+		switch t := f.(type) {
+		case *TokenFilterSynonym:
+			if p := export("filter", name, "synonyms", ruleLines("filter", name, t.synonyms, t.rawSynonyms)); p != "" {
+				rewrite(func() { t.synonyms, t.rawSynonyms, t.synonymsPath = make([]*MappingRule, 0), make([]string, 0), p })
+			} else {
+				external(name, "synonyms_path", t.synonymsPath)
+			}
+		case *TokenFilterSynonymGraph:
+			if p := export("filter", name, "synonyms", ruleLines("filter", name, t.synonyms, t.rawSynonyms)); p != "" {
+				rewrite(func() { t.synonyms, t.rawSynonyms, t.synonymsPath = make([]*MappingRule, 0), make([]string, 0), p })
+			} else {
+				external(name, "synonyms_path", t.synonymsPath)
+			}
+		case *TokenFilterStop:
+			stopwords("filter", name, &t.stopwords, &t.stopwordsPath)
+		case *TokenFilterKeepWords:
+			if p := export("filter", name, "keep_words", t.keepWords); p != "" {
+				rewrite(func() { t.keepWords, t.keepWordsPath = make([]string, 0), p })
+			} else {
+				external(name, "keep_words_path", t.keepWordsPath)
+			}
+		case *TokenFilterStemmerOverride:
+			if p := export("filter", name, "rules", ruleLines("filter", name, t.rules, nil)); p != "" {
+				rewrite(func() { t.rules, t.rulesPath = make([]*MappingRule, 0), p })
+			} else {
+				external(name, "rules_path", t.rulesPath)
+			}
+		case *TokenFilterKeywordMarker:
+			if p := export("filter", name, "keywords", t.keywords); p != "" {
+				rewrite(func() { t.keywords, t.keywordsPath = make([]string, 0), p })
+			} else {
+				external(name, "keywords_path", t.keywordsPath)
+			}
+		case *TokenFilterElision:
+			if p := export("filter", name, "articles", t.articles); p != "" {
+				rewrite(func() { t.articles, t.articlesPath = make([]string, 0), p })
+			} else {
+				external(name, "articles_path", t.articlesPath)
+			}
+		case *TokenFilterCommonGrams:
+			if p := export("filter", name, "common_words", t.commonWords); p != "" {
+				rewrite(func() { t.commonWords, t.commonWordsPath = make([]string, 0), p })
+			} else {
+				external(name, "common_words_path", t.commonWordsPath)
+			}
+		case *TokenFilterDictionaryDecompounder:
+			if p := export("filter", name, "word_list", t.wordList); p != "" {
+				rewrite(func() { t.wordList, t.wordListPath = make([]string, 0), p })
+			} else {
+				external(name, "word_list_path", t.wordListPath)
+			}
+		case *TokenFilterHyphenationDecompounder:
+			if p := export("filter", name, "word_list", t.wordList); p != "" {
+				rewrite(func() { t.wordList, t.wordListPath = make([]string, 0), p })
+			} else {
+				external(name, "word_list_path", t.wordListPath)
+			}
+			external(name, "hyphenation_patterns_path", t.hyphenationPatternsPath)
+		case *TokenFilterWordDelimiter:
+			if p := export("filter", name, "protected_words", t.protectedWords); p != "" {
+				rewrite(func() { t.protectedWords, t.protectedWordsPath = make([]string, 0), p })
+			} else {
+				external(name, "protected_words_path", t.protectedWordsPath)
+			}
+			if p := export("filter", name, "type_table", t.typeTable); p != "" {
+				rewrite(func() { t.typeTable, t.typeTablePath = make([]string, 0), p })
+			} else {
+				external(name, "type_table_path", t.typeTablePath)
+			}
+		case *TokenFilterWordDelimiterGraph:
+			if p := export("filter", name, "protected_words", t.protectedWords); p != "" {
+				rewrite(func() { t.protectedWords, t.protectedWordsPath = make([]string, 0), p })
+			} else {
+				external(name, "protected_words_path", t.protectedWordsPath)
+			}
+			if p := export("filter", name, "type_table", t.typeTable); p != "" {
+				rewrite(func() { t.typeTable, t.typeTablePath = make([]string, 0), p })
+			} else {
+				external(name, "type_table_path", t.typeTablePath)
+			}
+		case *TokenFilterHunspell:
+			if t.locale == "" {
+				if err == nil {
+					err = fmt.Errorf("missing locale for hunspell filter [%s]", name)
+				}
+				continue
+			}
+			external(name, "locale", path.Join("hunspell", t.locale))
+		}
+	}
+	for _, f := range a.charFilter {
+		name := f.Name()
+		switch t := f.(type) {
+		case *CharacterFilterMappingChar:
+			if p := export("char_filter", name, "mappings", ruleLines("char_filter", name, t.mappings, t.rawMappings)); p != "" {
+				rewrite(func() { t.mappings, t.rawMappings, t.mappingsPath = make([]*MappingRule, 0), make([]string, 0), p })
+			} else {
+				external(name, "mappings_path", t.mappingsPath)
+			}
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	for _, fn := range apply {
+		fn()
+	}
+	return m, nil
+}
+
+// mappingRuleLines returns the lines of a mapping rules file.
+func mappingRuleLines(rules []*MappingRule, rawRules []string) ([]string, error) {
+	lines := make([]string, 0, len(rules)+len(rawRules))
+	for i, r := range rules {
+		src, err := r.Source()
+		if err != nil {
+			return nil, err
+		}
+		line, ok := src.(string)
+		if !ok {
+			return nil, fmt.Errorf("empty mapping rule #%d", i)
+		}
+		lines = append(lines, line)
+	}
+	return append(lines, rawRules...), nil
+}
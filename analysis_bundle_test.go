@@ -0,0 +1,189 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAnalysisBundleExport(t *testing.T) {
+	a := NewAnalysis().
+		Analyzer(NewAnalyzerStop("my_stop").Stopwords("foo", "bar")).
+		Filter(
+			NewTokenFilterSynonym("my_synonyms").Synonyms(NewMappingRule("i-pod, i pod", "ipod"), NewMappingRule("", "").Value("universe, cosmos")),
+			NewTokenFilterStop("english_stop").Stopwords("_english_", "foo"),
+			NewTokenFilterKeepWords("my_keep").KeepWords("one"),
+			NewTokenFilterHunspell("en_US").Locale("en_US"),
+		).
+		CharFilter(NewCharacterFilterMappingChar("my_mapping").Mappings(NewMappingRule("٠", "0"), NewMappingRule("١", "1")))
+	m, err := NewAnalysisBundle("analysis").MinSize(2).Export(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := a.Source(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	expected := `{"analysis":{"analyzer":{"my_stop":{"stopwords_path":"analysis/analyzer/my_stop_stopwords.txt","type":"stop"}},"char_filter":{"my_mapping":{"mappings_path":"analysis/char_filter/my_mapping_mappings.txt","type":"mapping"}},"filter":{"en_US":{"locale":"en_US","type":"hunspell"},"english_stop":{"stopwords":["_english_","foo"],"type":"stop"},"my_keep":{"keep_words":["one"],"type":"keep"},"my_synonyms":{"synonyms_path":"analysis/filter/my_synonyms_synonyms.txt","type":"synonym"}}}}`
+	if got := string(data); got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+
+	src, err = m.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	expected = `{"files":[{"component":"my_stop","option":"stopwords_path","path":"analysis/analyzer/my_stop_stopwords.txt","sha256":"d78931fcf2660108eec0d6674ecb4e02401b5256a6b5ee82527766ef6d198c67","size":8},{"component":"my_synonyms","option":"synonyms_path","path":"analysis/filter/my_synonyms_synonyms.txt","sha256":"be7958c1aa9dae6cd77e1fb468157fba2661faf8a3127e7a8971c8953929568a","size":38},{"component":"en_US","external":true,"option":"locale","path":"hunspell/en_US"},{"component":"my_mapping","option":"mappings_path","path":"analysis/char_filter/my_mapping_mappings.txt","sha256":"c93aa3ece61e900641671581ffb10b11e9cdfc2cf373f43d677132b2a451236e","size":16}]}`
+	if got := string(data); got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+
+	dir, err := ioutil.TempDir("", "estemplate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := m.Write(dir, "analysis"); err != nil {
+		t.Fatal(err)
+	}
+	content, err := ioutil.ReadFile(filepath.Join(dir, "analysis", "filter", "my_synonyms_synonyms.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := string(content), "i-pod, i pod => ipod\nuniverse, cosmos\n"; got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "analysis", "manifest.json")); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAnalysisBundleExportConflict(t *testing.T) {
+	a := NewAnalysis().
+		Analyzer(NewAnalyzerStop("my_stop").Stopwords("foo")).
+		Filter(NewTokenFilterStop("my_stop").Stopwords("bar"), NewTokenFilterStop("my_stop").Stopwords("baz"))
+	src, err := a.Source(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	if _, err := NewAnalysisBundle("analysis").Export(a); err == nil {
+		t.Error("expected conflicting bundle file error, got nil")
+	}
+	if src, err = a.Source(true); err != nil {
+		t.Fatal(err)
+	}
+	after, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	if string(before) != string(after) {
+		t.Errorf("expected unchanged analysis\n%s\n,got:\n%s", before, after)
+	}
+}
+
+func TestAnalysisBundleExportHunspellLocale(t *testing.T) {
+	a := NewAnalysis().
+		Analyzer(NewAnalyzerStop("my_stop").Stopwords("foo")).
+		Filter(NewTokenFilterHunspell("my_hunspell").Dictionary("en_US.dic"))
+	if _, err := NewAnalysisBundle("analysis").Export(a); err == nil {
+		t.Error("expected missing hunspell locale error, got nil")
+	}
+	src, err := a.Source(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	expected := `{"analysis":{"analyzer":{"my_stop":{"stopwords":"foo","type":"stop"}},"filter":{"my_hunspell":{"dictionary":"en_US.dic","type":"hunspell"}}}}`
+	if got := string(data); got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestAnalysisBundleExportComponentKinds(t *testing.T) {
+	a := NewAnalysis().
+		Analyzer(NewAnalyzerStop("my_stop").Stopwords("foo")).
+		Filter(NewTokenFilterStop("my_stop").Stopwords("bar"))
+	m, err := NewAnalysisBundle("analysis").Export(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, f := range m.Files {
+		paths = append(paths, f.Path)
+	}
+	if got, expected := strings.Join(paths, " "), "analysis/analyzer/my_stop_stopwords.txt analysis/filter/my_stop_stopwords.txt"; got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestAnalysisBundleExportInvalid(t *testing.T) {
+	tests := []struct {
+		desc     string
+		a        *Analysis
+		expected string
+	}{
+		// #0
+		{
+			desc:     "Component name with path separator.",
+			a:        NewAnalysis().Filter(NewTokenFilterKeepWords("../../evil").KeepWords("one")),
+			expected: "invalid bundle file name for filter [../../evil]",
+		},
+		// #1
+		{
+			desc:     "Empty mapping rule.",
+			a:        NewAnalysis().Filter(NewTokenFilterSynonym("my_synonyms").Synonyms(NewMappingRule("", ""))),
+			expected: "filter [my_synonyms]: empty mapping rule #0",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := NewAnalysisBundle("analysis").Export(test.a)
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), test.expected) {
+				t.Errorf("expected error to contain %q, got: %v", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestAnalysisBundleManifestWriteOutside(t *testing.T) {
+	dir, err := ioutil.TempDir("", "estemplate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	m := &AnalysisBundleManifest{
+		Files: []*AnalysisBundleFile{{Path: "analysis/../../evil.txt", Component: "test", Option: "keep_words_path", Content: []byte("one\n")}},
+	}
+	if err := m.Write(filepath.Join(dir, "config"), "analysis"); err == nil {
+		t.Error("expected bundle file outside of the config directory error, got nil")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "evil.txt")); !os.IsNotExist(err) {
+		t.Errorf("expected evil.txt not to be written, got: %v", err)
+	}
+}
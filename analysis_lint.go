@@ -0,0 +1,158 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// filterRole role of a token filter in regards to token graphs and stemming.
+type filterRole int
+
+const (
+	filterRoleNone filterRole = iota
+	filterRoleGraph
+	filterRoleFlatten
+	filterRoleSynonym
+	filterRoleStemmer
+)
+
+// filterRole resolves the role of a token filter by name, custom token filters of the Analysis
+// taking precedence over built-in token filters.
+func (a *Analysis) filterRole(name string) filterRole {
+	for _, f := range a.filter {
+		if f.Name() != name {
+			continue
+		}
+		switch f.(type) {
+		case *TokenFilterSynonymGraph, *TokenFilterWordDelimiterGraph:
+			return filterRoleGraph
+		case *TokenFilterFlattenGraph:
+			return filterRoleFlatten
+		case *TokenFilterSynonym:
+			return filterRoleSynonym
+		case *TokenFilterStemmer, *TokenFilterSnowball, *TokenFilterKStem, *TokenFilterPorterStem,
+			*TokenFilterHunspell, *TokenFilterPolishStem, *TokenFilterKuromojiStemmer:
+			return filterRoleStemmer
+		}
+		return filterRoleNone
+	}
+	switch name {
+	case "synonym_graph", "word_delimiter_graph":
+		return filterRoleGraph
+	case "flatten_graph":
+		return filterRoleFlatten
+	case "synonym":
+		return filterRoleSynonym
+	case "stemmer", "snowball", "kstem", "porter_stem", "hunspell", "polish_stem", "kuromoji_stemmer":
+		return filterRoleStemmer
+	}
+	return filterRoleNone
+}
+
+// LintFilterOrder lints the token filter chains of the custom analyzers for ordering problems
+// which Elasticsearch accepts but which break analysis:
+// - a graph token filter (`synonym_graph`, `word_delimiter_graph`) in an index analyzer without
+// a following `flatten_graph` token filter, as the index does not store token graphs.
+// - a graph token filter used inside a `multiplexer` or `condition` token filter, as these do
+// not preserve token graphs.
+// - a `synonym` or `synonym_graph` token filter placed after a stemmer.
+// All analyzers are considered index analyzers except the ones listed in searchAnalyzers, which
+// are only used as `search_analyzer` or `search_quote_analyzer`.
+// Each warning is prefixed with the analyzer name and explains the problem.
+func (a *Analysis) LintFilterOrder(searchAnalyzers ...string) []string {
+	searchOnly := make(map[string]bool)
+	for _, name := range searchAnalyzers {
+		searchOnly[name] = true
+	}
+	var warnings []string
+	warnf := func(analyzer, format string, args ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf("analyzer [%s]: ", analyzer)+fmt.Sprintf(format, args...))
+	}
+
+	analyzers := a.analyzer
+	if a.defaultAnalyzer != nil {
+		analyzers = append([]Analyzer{a.defaultAnalyzer}, analyzers...)
+	}
+	seen := make(map[Analyzer]bool)
+	for _, _a := range analyzers {
+		c, ok := _a.(*AnalyzerCustom)
+		if !ok || seen[_a] {
+			continue
+		}
+		seen[_a] = true
+
+		var graph, stemmer string
+		for _, name := range c.filter {
+			role := a.filterRole(name)
+			if synonym := role == filterRoleSynonym || (role == filterRoleGraph && a.isSynonymGraphFilter(name)); synonym && stemmer != "" {
+				warnf(c.name, "synonym filter [%s] is placed after stemmer [%s], synonym rules are "+
+					"parsed and matched against stemmed tokens, which can silently change or break "+
+					"multi-word rules; place synonym filters before stemmers", name, stemmer)
+			}
+			switch role {
+			case filterRoleGraph:
+				if graph == "" {
+					graph = name
+				}
+			case filterRoleFlatten:
+				graph = ""
+			case filterRoleStemmer:
+				if stemmer == "" {
+					stemmer = name
+				}
+			}
+			for _, nested := range a.wrappedFilters(name) {
+				if a.filterRole(nested) == filterRoleGraph {
+					warnf(c.name, "graph filter [%s] is used inside [%s], multiplexer and condition "+
+						"filters do not preserve token graphs, so multi-token synonyms and word parts "+
+						"get wrong positions; use the graph filter directly in the analyzer", nested, name)
+				}
+			}
+		}
+		if graph != "" && !searchOnly[c.name] {
+			warnf(c.name, "graph filter [%s] is used in an index analyzer without a following "+
+				"flatten_graph filter, token graphs are not stored in the index and positions get "+
+				"corrupted; add flatten_graph after it or only use the analyzer at search time", graph)
+		}
+	}
+	return warnings
+}
+
+// isSynonymGraphFilter returns whether the token filter is a `synonym_graph` token filter.
+func (a *Analysis) isSynonymGraphFilter(name string) bool {
+	for _, f := range a.filter {
+		if f.Name() == name {
+			_, ok := f.(*TokenFilterSynonymGraph)
+			return ok
+		}
+	}
+	return name == "synonym_graph"
+}
+
+// wrappedFilters returns the token filters wrapped by a `multiplexer` or `condition` token
+// filter, nil for other token filters.
+func (a *Analysis) wrappedFilters(name string) []string {
+	var wrapped []string
+	for _, f := range a.filter {
+		if f.Name() != name {
+			continue
+		}
+		switch t := f.(type) {
+		case *TokenFilterMultiplexer:
+			// filters can be chained using comma-delimited strings.
+			for _, chain := range t.filters {
+				for _, n := range strings.Split(chain, ",") {
+					wrapped = append(wrapped, strings.TrimSpace(n))
+				}
+			}
+		case *TokenFilterConditional:
+			wrapped = append(wrapped, t.filter...)
+		}
+		break
+	}
+	return wrapped
+}
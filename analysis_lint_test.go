@@ -0,0 +1,77 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAnalysisLintFilterOrder(t *testing.T) {
+	tests := []struct {
+		desc            string
+		a               *Analysis
+		searchAnalyzers []string
+		expected        []string
+	}{
+		// #0
+		{
+			desc: "Graph filter followed by flatten_graph.",
+			a: NewAnalysis().
+				Analyzer(NewAnalyzerCustom("test", "standard").Filter("lowercase", "synonyms", "flatten_graph", "stemmer")).
+				Filter(NewTokenFilterSynonymGraph("synonyms")),
+			expected: nil,
+		},
+		// #1
+		{
+			desc:     "Graph filter in index analyzer.",
+			a:        NewAnalysis().Analyzer(NewAnalyzerCustom("test", "standard").Filter("word_delimiter_graph", "lowercase")),
+			expected: []string{"analyzer [test]: graph filter [word_delimiter_graph] is used in an index analyzer without a following flatten_graph filter"},
+		},
+		// #2
+		{
+			desc:            "Graph filter in search analyzer.",
+			a:               NewAnalysis().Analyzer(NewAnalyzerCustom("test", "standard").Filter("synonym_graph")),
+			searchAnalyzers: []string{"test"},
+			expected:        nil,
+		},
+		// #3
+		{
+			desc: "Graph filter inside multiplexer and condition.",
+			a: NewAnalysis().
+				DefaultAnalyzer(NewAnalyzerCustom("test", "standard").Filter("multiplexer", "conditional")).
+				Filter(
+					NewTokenFilterMultiplexer("multiplexer").Filters("lowercase", "lowercase, synonyms"),
+					NewTokenFilterConditional("conditional").Filter("word_delimiter_graph"),
+					NewTokenFilterSynonymGraph("synonyms"),
+				),
+			expected: []string{
+				"analyzer [test]: graph filter [synonyms] is used inside [multiplexer]",
+				"analyzer [test]: graph filter [word_delimiter_graph] is used inside [conditional]",
+			},
+		},
+		// #4
+		{
+			desc: "Synonym after stemmer.",
+			a: NewAnalysis().
+				Analyzer(NewAnalyzerCustom("test", "standard").Filter("lowercase", "english_stemmer", "synonym")).
+				Filter(NewTokenFilterStemmer("english_stemmer").Language("english")),
+			expected: []string{"analyzer [test]: synonym filter [synonym] is placed after stemmer [english_stemmer]"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			warnings := test.a.LintFilterOrder(test.searchAnalyzers...)
+			if len(warnings) != len(test.expected) {
+				t.Fatalf("expected %d warnings, got: %v", len(test.expected), warnings)
+			}
+			for i, expected := range test.expected {
+				if !strings.HasPrefix(warnings[i], expected) {
+					t.Errorf("expected\n%s\n,got:\n%s", expected, warnings[i])
+				}
+			}
+		})
+	}
+}
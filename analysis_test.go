@@ -45,6 +45,13 @@ func TestAnalysisSerialization(t *testing.T) {
 			expected:    `{"analysis":{"char_filter":{"custom_mapping":{"mappings":["٠ =\u003e 0","١ =\u003e 1","٢ =\u003e 2"],"type":"mapping"}}}}`,
 		},
 		// #4
+		{
+			desc:        "Include Name with Tokenizers.",
+			a:           NewAnalysis().Tokenizer(NewTokenizerICU("custom_icu").RuleFiles("Latn:KeywordTokenizer.rbbi")),
+			includeName: true,
+			expected:    `{"analysis":{"tokenizer":{"custom_icu":{"rule_files":"Latn:KeywordTokenizer.rbbi","type":"icu_tokenizer"}}}}`,
+		},
+		// #5
 		{
 			desc:        "Exclude Name.",
 			a:           NewAnalysis(),
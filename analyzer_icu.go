@@ -0,0 +1,112 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// AnalyzerICU (Plugin) analyzer that performs basic normalization, tokenization and character
+// folding, using the `icu_normalizer` character filter, `icu_tokenizer` and `icu_folding`
+// token filter.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-icu-analyzer.html
+// for details.
+type AnalyzerICU struct {
+	Analyzer
+	name string
+
+	// fields specific to icu analyzer
+	method string
+	mode   string
+}
+
+// NewAnalyzerICU initializes a new AnalyzerICU.
+func NewAnalyzerICU(name string) *AnalyzerICU {
+	return &AnalyzerICU{
+		name: name,
+	}
+}
+
+// Name returns field key for the Analyzer.
+func (i *AnalyzerICU) Name() string {
+	return i.name
+}
+
+// Method sets the normalization method.
+// Can be set to the following values:
+// "nfc" - Canonical Decomposition, followed by Canonical Composition.
+// "nfkc" - Compatibility Decomposition, followed by Canonical Composition.
+// "nfkc_cf" - Same as "nfkc" with case folding.
+// Defaults to "nfkc_cf".
+func (i *AnalyzerICU) Method(method string) *AnalyzerICU {
+	i.method = method
+	return i
+}
+
+// Mode sets the normalization mode.
+// Can be set to the following values:
+// "compose" - Compose the characters.
+// "decompose" - Decompose the characters.
+// Defaults to "compose".
+func (i *AnalyzerICU) Mode(mode string) *AnalyzerICU {
+	i.mode = mode
+	return i
+}
+
+// Validate validates AnalyzerICU.
+func (i *AnalyzerICU) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && i.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if i.method != "" {
+		if _, valid := map[string]bool{
+			"nfc":     true,
+			"nfkc":    true,
+			"nfkc_cf": true,
+		}[i.method]; !valid {
+			invalid = append(invalid, "Method")
+		}
+	}
+	if i.mode != "" {
+		if _, valid := map[string]bool{
+			"compose":   true,
+			"decompose": true,
+		}[i.mode]; !valid {
+			invalid = append(invalid, "Mode")
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (i *AnalyzerICU) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "icu_analyzer",
+	// 		"method": "nfkc_cf",
+	// 		"mode": "compose"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "icu_analyzer"
+
+	if i.method != "" {
+		options["method"] = i.method
+	}
+	if i.mode != "" {
+		options["mode"] = i.mode
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[i.name] = options
+	return source, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// AnalyzerKuromoji (Plugin) analyzer for Japanese text, built from the `kuromoji_tokenizer` and
+// the `kuromoji_baseform`, `kuromoji_part_of_speech`, `cjk_width`, `ja_stop`, `kuromoji_stemmer`
+// and `lowercase` token filters.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-kuromoji-analyzer.html
+// for details.
+type AnalyzerKuromoji struct {
+	Analyzer
+	name string
+
+	// fields specific to kuromoji analyzer
+	mode           string
+	userDictionary string
+}
+
+// NewAnalyzerKuromoji initializes a new AnalyzerKuromoji.
+func NewAnalyzerKuromoji(name string) *AnalyzerKuromoji {
+	return &AnalyzerKuromoji{
+		name: name,
+	}
+}
+
+// Name returns field key for the Analyzer.
+func (k *AnalyzerKuromoji) Name() string {
+	return k.name
+}
+
+// Mode sets the tokenization mode which determines how the tokenizer handles compound and unknown words.
+// Can be set to the following values:
+// "normal" - Normal segmentation, no decomposition for compounds.
+// "search" - Segmentation geared towards search.
+// "extended" - Extended mode outputs unigrams for unknown words.
+// Defaults to "search".
+func (k *AnalyzerKuromoji) Mode(mode string) *AnalyzerKuromoji {
+	k.mode = mode
+	return k
+}
+
+// UserDictionary sets the path to a MeCab user dictionary file in CSV format, relative to the
+// Elasticsearch `config` directory.
+func (k *AnalyzerKuromoji) UserDictionary(userDictionary string) *AnalyzerKuromoji {
+	k.userDictionary = userDictionary
+	return k
+}
+
+// Validate validates AnalyzerKuromoji.
+func (k *AnalyzerKuromoji) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && k.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if k.mode != "" {
+		if _, valid := map[string]bool{
+			"normal":   true,
+			"search":   true,
+			"extended": true,
+		}[k.mode]; !valid {
+			invalid = append(invalid, "Mode")
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (k *AnalyzerKuromoji) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "kuromoji",
+	// 		"mode": "search",
+	// 		"user_dictionary": "userdict_ja.txt"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "kuromoji"
+
+	if k.mode != "" {
+		options["mode"] = k.mode
+	}
+	if k.userDictionary != "" {
+		options["user_dictionary"] = k.userDictionary
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[k.name] = options
+	return source, nil
+}
@@ -0,0 +1,301 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// Language language supported by the built-in language analyzers.
+type Language string
+
+// Languages supported by the built-in language analyzers.
+const (
+	LanguageArabic     Language = "arabic"
+	LanguageArmenian   Language = "armenian"
+	LanguageBasque     Language = "basque"
+	LanguageBengali    Language = "bengali"
+	LanguageBrazilian  Language = "brazilian"
+	LanguageBulgarian  Language = "bulgarian"
+	LanguageCatalan    Language = "catalan"
+	LanguageCJK        Language = "cjk"
+	LanguageCzech      Language = "czech"
+	LanguageDanish     Language = "danish"
+	LanguageDutch      Language = "dutch"
+	LanguageEnglish    Language = "english"
+	LanguageEstonian   Language = "estonian"
+	LanguageFinnish    Language = "finnish"
+	LanguageFrench     Language = "french"
+	LanguageGalician   Language = "galician"
+	LanguageGerman     Language = "german"
+	LanguageGreek      Language = "greek"
+	LanguageHindi      Language = "hindi"
+	LanguageHungarian  Language = "hungarian"
+	LanguageIndonesian Language = "indonesian"
+	LanguageIrish      Language = "irish"
+	LanguageItalian    Language = "italian"
+	LanguageLatvian    Language = "latvian"
+	LanguageLithuanian Language = "lithuanian"
+	LanguageNorwegian  Language = "norwegian"
+	LanguagePersian    Language = "persian"
+	LanguagePortuguese Language = "portuguese"
+	LanguageRomanian   Language = "romanian"
+	LanguageRussian    Language = "russian"
+	LanguageSorani     Language = "sorani"
+	LanguageSpanish    Language = "spanish"
+	LanguageSwedish    Language = "swedish"
+	LanguageTurkish    Language = "turkish"
+	LanguageThai       Language = "thai"
+)
+
+// Valid returns whether the language has a built-in language analyzer.
+func (l Language) Valid() bool {
+	return l.Stopwords().Valid()
+}
+
+// Stopwords returns the pre-defined stop words list used by the language analyzer by default.
+func (l Language) Stopwords() Stopwords {
+	return Stopwords("_" + string(l) + "_")
+}
+
+// SupportsStemExclusion returns whether the language analyzer supports the `stem_exclusion`
+// parameter.
+func (l Language) SupportsStemExclusion() bool {
+	switch l {
+	case LanguageBrazilian, LanguageCJK, LanguageDanish, LanguageEstonian, LanguageGreek, LanguagePersian, LanguageThai:
+		return false
+	}
+	return l.Valid()
+}
+
+// AnalyzerLanguage analyzer that is aimed at analyzing text of a specific language.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-lang-analyzer.html
+// for details.
+type AnalyzerLanguage struct {
+	Analyzer
+	name string
+
+	// fields specific to language analyzer
+	language      Language
+	stopwords     []string
+	stopwordsPath string
+	stemExclusion []string
+}
+
+// NewAnalyzerLanguage initializes a new AnalyzerLanguage.
+func NewAnalyzerLanguage(name string, language Language) *AnalyzerLanguage {
+	return &AnalyzerLanguage{
+		name:          name,
+		language:      language,
+		stemExclusion: make([]string, 0),
+	}
+}
+
+// Name returns field key for the Analyzer.
+func (l *AnalyzerLanguage) Name() string {
+	return l.name
+}
+
+// Language sets the language of the analyzer.
+func (l *AnalyzerLanguage) Language(language Language) *AnalyzerLanguage {
+	l.language = language
+	return l
+}
+
+// Stopwords sets a pre-defined stop words list like "_english_" or an array containing
+// a list of stop words.
+// Defaults to the language specific stop words list, e.g. "_english_".
+func (l *AnalyzerLanguage) Stopwords(stopwords ...string) *AnalyzerLanguage {
+	l.stopwords = append(l.stopwords, stopwords...)
+	return l
+}
+
+// StopwordsPath sets the path to a file containing stop words. This path is relative to
+// the Elasticsearch `config` directory.
+func (l *AnalyzerLanguage) StopwordsPath(stopwordsPath string) *AnalyzerLanguage {
+	l.stopwordsPath = stopwordsPath
+	return l
+}
+
+// StemExclusion sets a list of lowercase words which should not be stemmed.
+func (l *AnalyzerLanguage) StemExclusion(stemExclusion ...string) *AnalyzerLanguage {
+	l.stemExclusion = append(l.stemExclusion, stemExclusion...)
+	return l
+}
+
+// Validate validates AnalyzerLanguage.
+func (l *AnalyzerLanguage) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && l.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if !l.language.Valid() {
+		invalid = append(invalid, "Language")
+	}
+	if err := ValidateStopwords(l.stopwords...); err != nil {
+		invalid = append(invalid, "Stopwords")
+	}
+	if len(l.stemExclusion) > 0 && !l.language.SupportsStemExclusion() {
+		invalid = append(invalid, "StemExclusion")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Rebuild expands the language analyzer into the equivalent `custom` analyzer, together with
+// the token filters and character filters it depends on, so it can be further customised. The
+// custom analyzer keeps the name of the language analyzer, while the filters are prefixed with
+// the language name, e.g. "english_stop".
+// The `keyword_marker` filter is only included when `stem_exclusion` is set.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-lang-analyzer.html
+// for details.
+func (l *AnalyzerLanguage) Rebuild() (*AnalyzerCustom, []TokenFilter, []CharacterFilter, error) {
+	if err := l.Validate(false); err != nil {
+		return nil, nil, nil, err
+	}
+	lang := string(l.language)
+
+	stop := NewTokenFilterStop(lang + "_stop")
+	if len(l.stopwords) > 0 {
+		stop.Stopwords(l.stopwords...)
+	} else if l.stopwordsPath == "" {
+		stop.Stopwords(l.language.Stopwords().String())
+	}
+	if l.stopwordsPath != "" {
+		stop.StopwordsPath(l.stopwordsPath)
+	}
+	var keywords TokenFilter
+	if len(l.stemExclusion) > 0 {
+		keywords = NewTokenFilterKeywordMarker(lang + "_keywords").Keywords(l.stemExclusion...)
+	}
+	stemmer := func(language string) TokenFilter {
+		return NewTokenFilterStemmer(lang + "_stemmer").Language(language)
+	}
+
+	var (
+		tokenizer   = "standard"
+		chain       []string
+		filters     []TokenFilter
+		charFilters []CharacterFilter
+	)
+	// use appends built-in filter names and custom token filters to the filter chain.
+	use := func(items ...interface{}) {
+		for _, item := range items {
+			switch f := item.(type) {
+			case string:
+				chain = append(chain, f)
+			case TokenFilter:
+				chain = append(chain, f.Name())
+				filters = append(filters, f)
+			}
+		}
+	}
+
+	switch l.language {
+	case LanguageArabic:
+		use("lowercase", "decimal_digit", stop, "arabic_normalization", keywords, stemmer("arabic"))
+	case LanguageBengali:
+		use("lowercase", "decimal_digit", keywords, "indic_normalization", "bengali_normalization", stop, stemmer("bengali"))
+	case LanguageCatalan:
+		elision := NewTokenFilterElision("catalan_elision").Articles("d", "l", "m", "n", "s", "t").ArticlesCase(true)
+		use(elision, "lowercase", stop, keywords, stemmer("catalan"))
+	case LanguageCJK:
+		use("cjk_width", "lowercase", "cjk_bigram", stop)
+	case LanguageDutch:
+		override := NewTokenFilterStemmerOverride("dutch_override").Rules(
+			NewMappingRule("fiets", "fiets"),
+			NewMappingRule("bromfiets", "bromfiets"),
+			NewMappingRule("ei", "eier"),
+			NewMappingRule("kind", "kinder"),
+		)
+		use("lowercase", stop, keywords, override, stemmer("dutch"))
+	case LanguageEnglish:
+		possessive := NewTokenFilterStemmer("english_possessive_stemmer").Language("possessive_english")
+		use(possessive, "lowercase", stop, keywords, stemmer("english"))
+	case LanguageFrench:
+		elision := NewTokenFilterElision("french_elision").Articles("l", "m", "t", "qu", "n", "s", "j", "d", "c", "jusqu", "quoiqu", "lorsqu", "puisqu").ArticlesCase(true)
+		use(elision, "lowercase", stop, keywords, stemmer("light_french"))
+	case LanguageGerman:
+		use("lowercase", stop, keywords, "german_normalization", stemmer("light_german"))
+	case LanguageGreek:
+		use(NewTokenFilterLowercase("greek_lowercase").Language("greek"), stop, keywords, stemmer("greek"))
+	case LanguageHindi:
+		use("lowercase", "decimal_digit", keywords, "indic_normalization", "hindi_normalization", stop, stemmer("hindi"))
+	case LanguageIrish:
+		hyphenation := NewTokenFilterStop("irish_hyphenation").Stopwords("h", "n", "t").IgnoreCase(true)
+		elision := NewTokenFilterElision("irish_elision").Articles("d", "m", "b").ArticlesCase(true)
+		use(hyphenation, elision, NewTokenFilterLowercase("irish_lowercase").Language("irish"), stop, keywords, stemmer("irish"))
+	case LanguageItalian:
+		elision := NewTokenFilterElision("italian_elision").Articles("c", "l", "all", "dall", "dell", "nell", "sull", "coll", "pell", "gl", "agl", "dagl", "degl", "negl", "sugl", "un", "m", "t", "s", "v", "d").ArticlesCase(true)
+		use(elision, "lowercase", stop, keywords, stemmer("light_italian"))
+	case LanguagePersian:
+		charFilters = append(charFilters, NewCharacterFilterMappingChar("zero_width_spaces").RawMappings(`\u200C=>\u0020`))
+		use("lowercase", "decimal_digit", "arabic_normalization", "persian_normalization", stop)
+	case LanguagePortuguese:
+		use("lowercase", stop, keywords, stemmer("light_portuguese"))
+	case LanguageSorani:
+		use("sorani_normalization", "lowercase", "decimal_digit", stop, keywords, stemmer("sorani"))
+	case LanguageSpanish:
+		use("lowercase", stop, keywords, stemmer("light_spanish"))
+	case LanguageThai:
+		tokenizer = "thai"
+		use("lowercase", "decimal_digit", stop)
+	case LanguageTurkish:
+		use("apostrophe", NewTokenFilterLowercase("turkish_lowercase").Language("turkish"), stop, keywords, stemmer("turkish"))
+	default:
+		use("lowercase", stop, keywords, stemmer(lang))
+	}
+
+	custom := NewAnalyzerCustom(l.name, tokenizer).Filter(chain...)
+	for _, c := range charFilters {
+		custom.CharFilter(c.Name())
+	}
+	return custom, filters, charFilters, nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (l *AnalyzerLanguage) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "english",
+	// 		"stopwords": ["_english_", "foo"],
+	// 		"stopwords_path": "stopwords_english.txt",
+	// 		"stem_exclusion": ["example"]
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = string(l.language)
+
+	if len(l.stopwords) > 0 {
+		var stopwords interface{}
+		switch {
+		case len(l.stopwords) > 1:
+			stopwords = l.stopwords
+			break
+		case len(l.stopwords) == 1:
+			stopwords = l.stopwords[0]
+			break
+		default:
+			stopwords = ""
+		}
+		options["stopwords"] = stopwords
+	}
+	if l.stopwordsPath != "" {
+		options["stopwords_path"] = l.stopwordsPath
+	}
+	if len(l.stemExclusion) > 0 {
+		options["stem_exclusion"] = l.stemExclusion
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[l.name] = options
+	return source, nil
+}
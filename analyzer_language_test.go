@@ -0,0 +1,105 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAnalyzerLanguageSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		l           *AnalyzerLanguage
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name.",
+			l:           NewAnalyzerLanguage("test", LanguageEnglish),
+			includeName: true,
+			expected:    `{"test":{"type":"english"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with Stopwords and StemExclusion.",
+			l:           NewAnalyzerLanguage("test", LanguageFrench).Stopwords("_french_", "foo").StemExclusion("organisation"),
+			includeName: false,
+			expected:    `{"stem_exclusion":["organisation"],"stopwords":["_french_","foo"],"type":"french"}`,
+		},
+		// #2
+		{
+			desc:        "Include Name with single Stopwords and StopwordsPath.",
+			l:           NewAnalyzerLanguage("test", LanguageGerman).Stopwords("_none_").StopwordsPath("stopwords_german.txt"),
+			includeName: true,
+			expected:    `{"test":{"stopwords":"_none_","stopwords_path":"stopwords_german.txt","type":"german"}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.l.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestAnalyzerLanguageRebuild(t *testing.T) {
+	tests := []struct {
+		desc     string
+		l        *AnalyzerLanguage
+		expected string
+	}{
+		// #0
+		{
+			desc:     "English with StemExclusion.",
+			l:        NewAnalyzerLanguage("rebuilt_english", LanguageEnglish).StemExclusion("example"),
+			expected: `{"analysis":{"analyzer":{"rebuilt_english":{"filter":["english_possessive_stemmer","lowercase","english_stop","english_keywords","english_stemmer"],"tokenizer":"standard","type":"custom"}},"filter":{"english_keywords":{"keywords":["example"],"type":"keyword_marker"},"english_possessive_stemmer":{"language":"possessive_english","type":"stemmer"},"english_stemmer":{"language":"english","type":"stemmer"},"english_stop":{"stopwords":"_english_","type":"stop"}}}}`,
+		},
+		// #1
+		{
+			desc:     "Persian with StopwordsPath.",
+			l:        NewAnalyzerLanguage("rebuilt_persian", LanguagePersian).StopwordsPath("stopwords_persian.txt"),
+			expected: `{"analysis":{"analyzer":{"rebuilt_persian":{"char_filter":["zero_width_spaces"],"filter":["lowercase","decimal_digit","arabic_normalization","persian_normalization","persian_stop"],"tokenizer":"standard","type":"custom"}},"char_filter":{"zero_width_spaces":{"mappings":"\\u200C=\u003e\\u0020","type":"mapping"}},"filter":{"persian_stop":{"stopwords_path":"stopwords_persian.txt","type":"stop"}}}}`,
+		},
+		// #2
+		{
+			desc:     "Thai.",
+			l:        NewAnalyzerLanguage("rebuilt_thai", LanguageThai),
+			expected: `{"analysis":{"analyzer":{"rebuilt_thai":{"filter":["lowercase","decimal_digit","thai_stop"],"tokenizer":"thai","type":"custom"}},"filter":{"thai_stop":{"stopwords":"_thai_","type":"stop"}}}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			analyzer, filters, charFilters, err := test.l.Rebuild()
+			if err != nil {
+				t.Fatal(err)
+			}
+			src, err := NewAnalysis().Analyzer(analyzer).Filter(filters...).CharFilter(charFilters...).Source(true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+	if _, _, _, err := NewAnalyzerLanguage("test", LanguageDanish).StemExclusion("foo").Rebuild(); err == nil {
+		t.Error("expected validation error for StemExclusion on danish, got nil")
+	}
+}
@@ -85,8 +85,13 @@ func (p *AnalyzerPattern) Validate(includeName bool) error {
 	if includeName && p.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if err := ValidateRegexFlags(p.flags...); err != nil {
+		invalid = append(invalid, "Flags")
+	} else if err := ValidateJavaRegex(p.pattern, p.flags...); err != nil {
+		invalid = append(invalid, "Pattern")
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
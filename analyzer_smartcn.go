@@ -0,0 +1,62 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// AnalyzerSmartcn (Plugin) analyzer for Chinese or mixed Chinese-English text, built from the
+// `smartcn_tokenizer` and the `porter_stem` and `smartcn_stop` token filters.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-smartcn.html
+// for details.
+type AnalyzerSmartcn struct {
+	Analyzer
+	name string
+
+	// fields specific to smartcn analyzer
+}
+
+// NewAnalyzerSmartcn initializes a new AnalyzerSmartcn.
+func NewAnalyzerSmartcn(name string) *AnalyzerSmartcn {
+	return &AnalyzerSmartcn{
+		name: name,
+	}
+}
+
+// Name returns field key for the Analyzer.
+func (s *AnalyzerSmartcn) Name() string {
+	return s.name
+}
+
+// Validate validates AnalyzerSmartcn.
+func (s *AnalyzerSmartcn) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && s.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (s *AnalyzerSmartcn) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "smartcn"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "smartcn"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[s.name] = options
+	return source, nil
+}
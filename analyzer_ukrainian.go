@@ -0,0 +1,110 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// AnalyzerUkrainian (Plugin) analyzer that provides stemming for Ukrainian, using the Morfologik
+// dictionary based stemmer. The Ukrainian stemmer is only exposed through this analyzer.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-ukrainian.html
+// for details.
+type AnalyzerUkrainian struct {
+	Analyzer
+	name string
+
+	// fields specific to ukrainian analyzer
+	stopwords     []string
+	stopwordsPath string
+	stemExclusion []string
+}
+
+// NewAnalyzerUkrainian initializes a new AnalyzerUkrainian.
+func NewAnalyzerUkrainian(name string) *AnalyzerUkrainian {
+	return &AnalyzerUkrainian{
+		name:          name,
+		stopwords:     make([]string, 0),
+		stemExclusion: make([]string, 0),
+	}
+}
+
+// Name returns field key for the Analyzer.
+func (u *AnalyzerUkrainian) Name() string {
+	return u.name
+}
+
+// Stopwords sets an array containing a list of stop words.
+// Defaults to the Ukrainian stop words list bundled with the plugin.
+func (u *AnalyzerUkrainian) Stopwords(stopwords ...string) *AnalyzerUkrainian {
+	u.stopwords = append(u.stopwords, stopwords...)
+	return u
+}
+
+// StopwordsPath sets the path to a file containing stop words. This path is relative to
+// the Elasticsearch `config` directory.
+func (u *AnalyzerUkrainian) StopwordsPath(stopwordsPath string) *AnalyzerUkrainian {
+	u.stopwordsPath = stopwordsPath
+	return u
+}
+
+// StemExclusion sets a list of lowercase words which should not be stemmed.
+func (u *AnalyzerUkrainian) StemExclusion(stemExclusion ...string) *AnalyzerUkrainian {
+	u.stemExclusion = append(u.stemExclusion, stemExclusion...)
+	return u
+}
+
+// Validate validates AnalyzerUkrainian.
+func (u *AnalyzerUkrainian) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && u.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (u *AnalyzerUkrainian) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "ukrainian",
+	// 		"stopwords": ["і", "та"],
+	// 		"stem_exclusion": ["київ"]
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "ukrainian"
+
+	if len(u.stopwords) > 0 {
+		var stopwords interface{}
+		switch {
+		case len(u.stopwords) > 1:
+			stopwords = u.stopwords
+			break
+		case len(u.stopwords) == 1:
+			stopwords = u.stopwords[0]
+			break
+		default:
+			stopwords = ""
+		}
+		options["stopwords"] = stopwords
+	}
+	if u.stopwordsPath != "" {
+		options["stopwords_path"] = u.stopwordsPath
+	}
+	if len(u.stemExclusion) > 0 {
+		options["stem_exclusion"] = u.stemExclusion
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[u.name] = options
+	return source, nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAnalyzerUkrainianSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		u           *AnalyzerUkrainian
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name.",
+			u:           NewAnalyzerUkrainian("test"),
+			includeName: true,
+			expected:    `{"test":{"type":"ukrainian"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with Stopwords and StemExclusion.",
+			u:           NewAnalyzerUkrainian("test").Stopwords("і", "та").StemExclusion("київ"),
+			includeName: false,
+			expected:    `{"stem_exclusion":["київ"],"stopwords":["і","та"],"type":"ukrainian"}`,
+		},
+		// #2
+		{
+			desc:        "Include Name with StopwordsPath.",
+			u:           NewAnalyzerUkrainian("test").StopwordsPath("stopwords_uk.txt"),
+			includeName: true,
+			expected:    `{"test":{"stopwords_path":"stopwords_uk.txt","type":"ukrainian"}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.u.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// CharacterFilterICUNormalizer (Plugin) character filter that normalizes characters as explained
+// in Unicode Normalization Forms. It is preferable to use this character filter instead of the
+// `icu_normalizer` token filter as normalization happens before tokenization.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-icu-normalization-charfilter.html
+// for details.
+type CharacterFilterICUNormalizer struct {
+	CharacterFilter
+	name string
+
+	// fields specific to icu normalizer character filter
+	normalization string
+	mode          string
+}
+
+// NewCharacterFilterICUNormalizer initializes a new CharacterFilterICUNormalizer.
+func NewCharacterFilterICUNormalizer(name string) *CharacterFilterICUNormalizer {
+	return &CharacterFilterICUNormalizer{
+		name: name,
+	}
+}
+
+// Name returns field key for the Character Filter.
+func (n *CharacterFilterICUNormalizer) Name() string {
+	return n.name
+}
+
+// NormalizationName sets the type of normalization.
+// Can be set to the following values:
+// "nfc" - Canonical Decomposition, followed by Canonical Composition.
+// "nfkc" - Compatibility Decomposition, followed by Canonical Composition.
+// "nfkc_cf" - Same as "nfkc" with case folding.
+// Defaults to "nfkc_cf".
+func (n *CharacterFilterICUNormalizer) NormalizationName(normalization string) *CharacterFilterICUNormalizer {
+	n.normalization = normalization
+	return n
+}
+
+// Mode sets the normalization mode.
+// Can be set to the following values:
+// "compose" - Compose the characters.
+// "decompose" - Decompose the characters, eg convert "nfc" to "nfd" or "nfkc" to "nfkd".
+// Defaults to "compose".
+func (n *CharacterFilterICUNormalizer) Mode(mode string) *CharacterFilterICUNormalizer {
+	n.mode = mode
+	return n
+}
+
+// Validate validates CharacterFilterICUNormalizer.
+func (n *CharacterFilterICUNormalizer) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && n.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if n.normalization != "" {
+		if _, valid := map[string]bool{
+			"nfc":     true,
+			"nfkc":    true,
+			"nfkc_cf": true,
+		}[n.normalization]; !valid {
+			invalid = append(invalid, "NormalizationName")
+		}
+	}
+	if n.mode != "" {
+		if _, valid := map[string]bool{
+			"compose":   true,
+			"decompose": true,
+		}[n.mode]; !valid {
+			invalid = append(invalid, "Mode")
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (n *CharacterFilterICUNormalizer) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "icu_normalizer",
+	// 		"name": "nfkc",
+	// 		"mode": "decompose"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "icu_normalizer"
+
+	if n.normalization != "" {
+		options["name"] = n.normalization
+	}
+	if n.mode != "" {
+		options["mode"] = n.mode
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[n.name] = options
+	return source, nil
+}
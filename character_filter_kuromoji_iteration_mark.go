@@ -0,0 +1,87 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// CharacterFilterKuromojiIterationMark (Plugin) character filter that normalizes Japanese
+// horizontal iteration marks (odoriji) to their expanded form.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-kuromoji-charfilter.html
+// for details.
+type CharacterFilterKuromojiIterationMark struct {
+	CharacterFilter
+	name string
+
+	// fields specific to kuromoji iteration mark character filter
+	normalizeKanji *bool
+	normalizeKana  *bool
+}
+
+// NewCharacterFilterKuromojiIterationMark initializes a new CharacterFilterKuromojiIterationMark.
+func NewCharacterFilterKuromojiIterationMark(name string) *CharacterFilterKuromojiIterationMark {
+	return &CharacterFilterKuromojiIterationMark{
+		name: name,
+	}
+}
+
+// Name returns field key for the Character Filter.
+func (m *CharacterFilterKuromojiIterationMark) Name() string {
+	return m.name
+}
+
+// NormalizeKanji sets whether kanji iteration marks should be normalized.
+// Defaults to true.
+func (m *CharacterFilterKuromojiIterationMark) NormalizeKanji(normalizeKanji bool) *CharacterFilterKuromojiIterationMark {
+	m.normalizeKanji = &normalizeKanji
+	return m
+}
+
+// NormalizeKana sets whether kana iteration marks should be normalized.
+// Defaults to true.
+func (m *CharacterFilterKuromojiIterationMark) NormalizeKana(normalizeKana bool) *CharacterFilterKuromojiIterationMark {
+	m.normalizeKana = &normalizeKana
+	return m
+}
+
+// Validate validates CharacterFilterKuromojiIterationMark.
+func (m *CharacterFilterKuromojiIterationMark) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && m.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (m *CharacterFilterKuromojiIterationMark) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "kuromoji_iteration_mark",
+	// 		"normalize_kanji": true,
+	// 		"normalize_kana": true
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "kuromoji_iteration_mark"
+
+	if m.normalizeKanji != nil {
+		options["normalize_kanji"] = m.normalizeKanji
+	}
+	if m.normalizeKana != nil {
+		options["normalize_kana"] = m.normalizeKana
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[m.name] = options
+	return source, nil
+}
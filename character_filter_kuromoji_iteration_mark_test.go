@@ -0,0 +1,49 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCharacterFilterKuromojiIterationMarkSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		m           *CharacterFilterKuromojiIterationMark
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name.",
+			m:           NewCharacterFilterKuromojiIterationMark("test"),
+			includeName: true,
+			expected:    `{"test":{"type":"kuromoji_iteration_mark"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with NormalizeKanji and NormalizeKana.",
+			m:           NewCharacterFilterKuromojiIterationMark("test").NormalizeKanji(true).NormalizeKana(false),
+			includeName: false,
+			expected:    `{"normalize_kana":false,"normalize_kanji":true,"type":"kuromoji_iteration_mark"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.m.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
@@ -75,6 +75,11 @@ func (c *CharacterFilterPatternReplaceChar) Validate(includeName bool) error {
 	if c.pattern == "" {
 		invalid = append(invalid, "Pattern")
 	}
+	if err := ValidateRegexFlags(c.flags...); err != nil {
+		invalid = append(invalid, "Flags")
+	} else if err := ValidateJavaRegex(c.pattern, c.flags...); err != nil {
+		invalid = append(invalid, "Pattern")
+	}
 	if len(invalid) > 0 {
 		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
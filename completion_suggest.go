@@ -0,0 +1,343 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"fmt"
+	"sort"
+)
+
+// completionContextValues values of a completion context, either categories or geo points.
+type completionContextValues struct {
+	typ    string
+	values []interface{}
+}
+
+// addCompletionContext appends values of a typ context to contexts, creating the context when
+// it does not exist yet.
+func addCompletionContext(contexts map[string]*completionContextValues, name, typ string, values ...interface{}) {
+	ctx, ok := contexts[name]
+	if !ok {
+		ctx = &completionContextValues{typ: typ}
+		contexts[name] = ctx
+	}
+	if ctx.typ != typ {
+		// mixing category and geo values is reported by the validation against the mapping.
+		ctx.typ = "mixed"
+	}
+	ctx.values = append(ctx.values, values...)
+}
+
+// geoPoint returns the serializable JSON of a geo point.
+func geoPoint(lat, lon float64) map[string]interface{} {
+	return map[string]interface{}{
+		"lat": lat,
+		"lon": lon,
+	}
+}
+
+// CompletionInput value of a completion field in a document, which defines the inputs of a
+// suggestion along with its weight and contexts.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/search-suggesters.html#indexing
+// for details.
+type CompletionInput struct {
+	input    []string
+	weight   *int
+	contexts map[string]*completionContextValues
+}
+
+// NewCompletionInput initializes a new CompletionInput.
+func NewCompletionInput(input ...string) *CompletionInput {
+	return &CompletionInput{
+		input:    input,
+		contexts: make(map[string]*completionContextValues),
+	}
+}
+
+// Input sets the inputs to store, which are suggested when they match the prefix of the
+// suggest request.
+func (i *CompletionInput) Input(input ...string) *CompletionInput {
+	i.input = append(i.input, input...)
+	return i
+}
+
+// Weight sets the weight used to rank the suggestion. Must be a positive integer.
+func (i *CompletionInput) Weight(weight int) *CompletionInput {
+	i.weight = &weight
+	return i
+}
+
+// CategoryContext sets the categories of the suggestion for the category context.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/suggester-context.html#suggester-context
+// for details.
+func (i *CompletionInput) CategoryContext(name string, categories ...string) *CompletionInput {
+	values := make([]interface{}, 0)
+	for _, category := range categories {
+		values = append(values, category)
+	}
+	addCompletionContext(i.contexts, name, "category", values...)
+	return i
+}
+
+// GeoContext adds a geo point of the suggestion for the geo context.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/suggester-context.html#geo-context
+// for details.
+func (i *CompletionInput) GeoContext(name string, lat, lon float64) *CompletionInput {
+	addCompletionContext(i.contexts, name, "geo", geoPoint(lat, lon))
+	return i
+}
+
+// Validate validates CompletionInput.
+func (i *CompletionInput) Validate() error {
+	var invalid []string
+	if len(i.input) == 0 {
+		invalid = append(invalid, "Input")
+	}
+	if i.weight != nil && *i.weight < 0 {
+		invalid = append(invalid, "Weight")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (i *CompletionInput) Source() (interface{}, error) {
+	// {
+	// 	"input": ["timmy's", "starbucks"],
+	// 	"weight": 34,
+	// 	"contexts": {
+	// 		"place_type": ["cafe", "food"],
+	// 		"location": [
+	// 			{
+	// 				"lat": 43.6624803,
+	// 				"lon": -79.3863353
+	// 			}
+	// 		]
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["input"] = i.input
+
+	if i.weight != nil {
+		options["weight"] = i.weight
+	}
+	if len(i.contexts) > 0 {
+		contexts := make(map[string]interface{})
+		for name, ctx := range i.contexts {
+			contexts[name] = ctx.values
+		}
+		options["contexts"] = contexts
+	}
+	return options, nil
+}
+
+// CompletionSuggest body of a suggest request `POST <index>/_search` on a completion field,
+// returning the suggestions matching a prefix, optionally filtered or boosted by contexts.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/search-suggesters.html#querying
+// for details.
+type CompletionSuggest struct {
+	name           string
+	field          string
+	prefix         string
+	size           *int
+	skipDuplicates *bool
+	contexts       map[string]*completionContextValues
+}
+
+// NewCompletionSuggest initializes a new CompletionSuggest, the name identifying the
+// suggestions in the response.
+func NewCompletionSuggest(name, field string) *CompletionSuggest {
+	return &CompletionSuggest{
+		name:     name,
+		field:    field,
+		contexts: make(map[string]*completionContextValues),
+	}
+}
+
+// Name returns field key for the CompletionSuggest.
+func (s *CompletionSuggest) Name() string {
+	return s.name
+}
+
+// Prefix sets the prefix to suggest completions for.
+func (s *CompletionSuggest) Prefix(prefix string) *CompletionSuggest {
+	s.prefix = prefix
+	return s
+}
+
+// Size sets the number of suggestions to return. Defaults to 5.
+func (s *CompletionSuggest) Size(size int) *CompletionSuggest {
+	s.size = &size
+	return s
+}
+
+// SkipDuplicates sets whether suggestions with duplicate inputs should be filtered out.
+// Defaults to false.
+func (s *CompletionSuggest) SkipDuplicates(skipDuplicates bool) *CompletionSuggest {
+	s.skipDuplicates = &skipDuplicates
+	return s
+}
+
+// CategoryContext filters the suggestions by the categories of the category context.
+func (s *CompletionSuggest) CategoryContext(name string, categories ...string) *CompletionSuggest {
+	values := make([]interface{}, 0)
+	for _, category := range categories {
+		values = append(values, category)
+	}
+	addCompletionContext(s.contexts, name, "category", values...)
+	return s
+}
+
+// GeoContext filters the suggestions by the geo point of the geo context, within the geohash
+// precision of the context.
+func (s *CompletionSuggest) GeoContext(name string, lat, lon float64) *CompletionSuggest {
+	addCompletionContext(s.contexts, name, "geo", map[string]interface{}{
+		"context": geoPoint(lat, lon),
+	})
+	return s
+}
+
+// Validate validates CompletionSuggest.
+func (s *CompletionSuggest) Validate() error {
+	var invalid []string
+	if s.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if s.field == "" {
+		invalid = append(invalid, "Field")
+	}
+	if s.prefix == "" {
+		invalid = append(invalid, "Prefix")
+	}
+	if s.size != nil && *s.size < 1 {
+		invalid = append(invalid, "Size")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (s *CompletionSuggest) Source() (interface{}, error) {
+	// {
+	// 	"suggest": {
+	// 		"place_suggestion": {
+	// 			"prefix": "tim",
+	// 			"completion": {
+	// 				"field": "suggest",
+	// 				"size": 10,
+	// 				"skip_duplicates": true,
+	// 				"contexts": {
+	// 					"place_type": ["cafe", "restaurants"]
+	// 				}
+	// 			}
+	// 		}
+	// 	}
+	// }
+	completion := make(map[string]interface{})
+	completion["field"] = s.field
+
+	if s.size != nil {
+		completion["size"] = s.size
+	}
+	if s.skipDuplicates != nil {
+		completion["skip_duplicates"] = s.skipDuplicates
+	}
+	if len(s.contexts) > 0 {
+		contexts := make(map[string]interface{})
+		for name, ctx := range s.contexts {
+			contexts[name] = ctx.values
+		}
+		completion["contexts"] = contexts
+	}
+
+	options := make(map[string]interface{})
+	options["prefix"] = s.prefix
+	options["completion"] = completion
+
+	source := make(map[string]interface{})
+	source["suggest"] = map[string]interface{}{
+		s.name: options,
+	}
+	return source, nil
+}
+
+// validateContexts validates context values against the contexts defined on the completion
+// datatype, by context name.
+func (c *DatatypeCompletion) validateContexts(contexts map[string]*completionContextValues) []string {
+	defined := make(map[string]string)
+	for _, ctx := range c.contexts {
+		defined[ctx.name] = ctx.typ
+	}
+	names := make([]string, 0)
+	for name := range contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var invalid []string
+	for _, name := range names {
+		typ, ok := defined[name]
+		if !ok {
+			invalid = append(invalid, fmt.Sprintf("context [%s] is not defined on completion field [%s]", name, c.name))
+			continue
+		}
+		if ctx := contexts[name]; ctx.typ != typ {
+			invalid = append(invalid, fmt.Sprintf("context [%s] of type [%s] only accepts %s values", name, typ, typ))
+		}
+	}
+	return invalid
+}
+
+// ValidateInput validates a completion input against the contexts defined on the completion
+// datatype: every context of the input must be defined with the same type, and suggestions of
+// context enabled fields must have contexts unless they are read from a `path`.
+func (c *DatatypeCompletion) ValidateInput(input *CompletionInput) error {
+	var invalid []string
+	if err := input.Validate(); err != nil {
+		invalid = append(invalid, err.Error())
+	}
+	invalid = append(invalid, c.validateContexts(input.contexts)...)
+	if len(c.contexts) > 0 && len(input.contexts) == 0 {
+		fromPath := false
+		for _, ctx := range c.contexts {
+			if ctx.path != "" {
+				fromPath = true
+			}
+		}
+		if !fromPath {
+			invalid = append(invalid, fmt.Sprintf("contexts are required by context enabled completion field [%s]", c.name))
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid completion input: %v", invalid)
+	}
+	return nil
+}
+
+// ValidateSuggest validates a suggest request against the contexts defined on the completion
+// datatype: every context of the request must be defined with the same type, and context
+// enabled fields must be queried with contexts.
+func (c *DatatypeCompletion) ValidateSuggest(suggest *CompletionSuggest) error {
+	var invalid []string
+	if err := suggest.Validate(); err != nil {
+		invalid = append(invalid, err.Error())
+	}
+	invalid = append(invalid, c.validateContexts(suggest.contexts)...)
+	if len(c.contexts) > 0 && len(suggest.contexts) == 0 {
+		invalid = append(invalid, fmt.Sprintf("contexts are required to query context enabled completion field [%s]", c.name))
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid completion suggest: %v", invalid)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestCompletionInputSerialization(t *testing.T) {
+	tests := []struct {
+		desc     string
+		i        *CompletionInput
+		expected string
+	}{
+		// #0
+		{
+			desc:     "With Input.",
+			i:        NewCompletionInput("timmy's", "starbucks"),
+			expected: `{"input":["timmy's","starbucks"]}`,
+		},
+		// #1
+		{
+			desc: "With Weight and contexts.",
+			i: NewCompletionInput("timmy's").Weight(34).
+				CategoryContext("place_type", "cafe", "food").
+				GeoContext("location", 43.6624803, -79.3863353),
+			expected: `{"contexts":{"location":[{"lat":43.6624803,"lon":-79.3863353}],"place_type":["cafe","food"]},"input":["timmy's"],"weight":34}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.i.Source()
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestCompletionSuggestSerialization(t *testing.T) {
+	tests := []struct {
+		desc     string
+		s        *CompletionSuggest
+		expected string
+	}{
+		// #0
+		{
+			desc:     "With Prefix.",
+			s:        NewCompletionSuggest("place_suggestion", "suggest").Prefix("tim"),
+			expected: `{"suggest":{"place_suggestion":{"completion":{"field":"suggest"},"prefix":"tim"}}}`,
+		},
+		// #1
+		{
+			desc: "With Size, SkipDuplicates and contexts.",
+			s: NewCompletionSuggest("place_suggestion", "suggest").Prefix("tim").Size(10).SkipDuplicates(true).
+				CategoryContext("place_type", "cafe", "restaurants").
+				GeoContext("location", 43.662, -79.38),
+			expected: `{"suggest":{"place_suggestion":{"completion":{"contexts":{"location":[{"context":{"lat":43.662,"lon":-79.38}}],"place_type":["cafe","restaurants"]},"field":"suggest","size":10,"skip_duplicates":true},"prefix":"tim"}}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.s.Source()
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestDatatypeCompletionValidateContexts(t *testing.T) {
+	c := NewDatatypeCompletion("suggest").Contexts(
+		NewCompletionContextCategory("place_type"),
+		NewCompletionContextGeo("location").Precision(4),
+	)
+	tests := []struct {
+		desc     string
+		validate func() error
+		expected []string
+	}{
+		// #0
+		{
+			desc: "Input with defined contexts.",
+			validate: func() error {
+				return c.ValidateInput(NewCompletionInput("timmy's").CategoryContext("place_type", "cafe").GeoContext("location", 43.66, -79.38))
+			},
+		},
+		// #1
+		{
+			desc: "Input with undefined and mistyped contexts.",
+			validate: func() error {
+				return c.ValidateInput(NewCompletionInput("timmy's").CategoryContext("brand", "tims").GeoContext("place_type", 43.66, -79.38))
+			},
+			expected: []string{
+				"context [brand] is not defined on completion field [suggest]",
+				"context [place_type] of type [category] only accepts category values",
+			},
+		},
+		// #2
+		{
+			desc: "Input without contexts.",
+			validate: func() error {
+				return c.ValidateInput(NewCompletionInput("timmy's"))
+			},
+			expected: []string{"contexts are required by context enabled completion field [suggest]"},
+		},
+		// #3
+		{
+			desc: "Input without contexts read from path.",
+			validate: func() error {
+				return NewDatatypeCompletion("suggest").Contexts(NewCompletionContextCategory("place_type").Path("cat")).
+					ValidateInput(NewCompletionInput("timmy's"))
+			},
+		},
+		// #4
+		{
+			desc: "Suggest with defined contexts.",
+			validate: func() error {
+				return c.ValidateSuggest(NewCompletionSuggest("place", "suggest").Prefix("tim").CategoryContext("place_type", "cafe"))
+			},
+		},
+		// #5
+		{
+			desc: "Suggest without contexts.",
+			validate: func() error {
+				return c.ValidateSuggest(NewCompletionSuggest("place", "suggest").Prefix("tim"))
+			},
+			expected: []string{"contexts are required to query context enabled completion field [suggest]"},
+		},
+		// #6
+		{
+			desc: "Suggest with mistyped context.",
+			validate: func() error {
+				return c.ValidateSuggest(NewCompletionSuggest("place", "suggest").Prefix("tim").CategoryContext("location", "toronto"))
+			},
+			expected: []string{"context [location] of type [geo] only accepts geo values"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.validate()
+			if len(test.expected) == 0 {
+				if err != nil {
+					t.Errorf("expected valid, got: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			for _, expected := range test.expected {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error to contain %q, got: %v", expected, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,366 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// timeValuePattern pattern of Elasticsearch time values, eg "30d" or "12h".
+var timeValuePattern = regexp.MustCompile(`^[0-9]+(d|h|m|s|ms|micros|nanos)$`)
+
+// byteSizeValuePattern pattern of Elasticsearch byte size values, eg "50gb".
+var byteSizeValuePattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?(b|kb|mb|gb|tb|pb)$`)
+
+// DataStream block of an index template which makes matching indices data streams, storing
+// append-only time series data across multiple backing indices. Requires Elasticsearch 7.9
+// or later.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.9/data-streams.html
+// for details.
+type DataStream struct {
+	hidden             *bool
+	allowCustomRouting *bool
+}
+
+// NewDataStream initializes a new DataStream.
+func NewDataStream() *DataStream {
+	return &DataStream{}
+}
+
+// Hidden sets whether the data stream is hidden. Defaults to false.
+func (d *DataStream) Hidden(hidden bool) *DataStream {
+	d.hidden = &hidden
+	return d
+}
+
+// AllowCustomRouting sets whether the data stream supports custom routing.
+// Defaults to false.
+func (d *DataStream) AllowCustomRouting(allowCustomRouting bool) *DataStream {
+	d.allowCustomRouting = &allowCustomRouting
+	return d
+}
+
+// Validate validates DataStream.
+func (d *DataStream) Validate() error {
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (d *DataStream) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"data_stream": {
+	// 		"hidden": false,
+	// 		"allow_custom_routing": false
+	// 	}
+	// }
+	options := make(map[string]interface{})
+
+	if d.hidden != nil {
+		options["hidden"] = d.hidden
+	}
+	if d.allowCustomRouting != nil {
+		options["allow_custom_routing"] = d.allowCustomRouting
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source["data_stream"] = options
+	return source, nil
+}
+
+// DataStreamLifecycle built-in lifecycle of a data stream, which retains the data for at least
+// the data retention period. Used in the `template` of index templates, or as the body of the
+// put data stream lifecycle API `PUT _data_stream/<name>/_lifecycle`. Requires Elasticsearch
+// 8.11 or later.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.11/data-stream-lifecycle.html
+// for details.
+type DataStreamLifecycle struct {
+	dataRetention string
+	enabled       *bool
+}
+
+// NewDataStreamLifecycle initializes a new DataStreamLifecycle.
+func NewDataStreamLifecycle() *DataStreamLifecycle {
+	return &DataStreamLifecycle{}
+}
+
+// DataRetention sets the minimum time to retain the data, eg "7d". Data is retained forever
+// when not set.
+func (l *DataStreamLifecycle) DataRetention(dataRetention string) *DataStreamLifecycle {
+	l.dataRetention = dataRetention
+	return l
+}
+
+// Enabled sets whether the lifecycle is applied to the data stream. Defaults to true.
+func (l *DataStreamLifecycle) Enabled(enabled bool) *DataStreamLifecycle {
+	l.enabled = &enabled
+	return l
+}
+
+// Validate validates DataStreamLifecycle.
+func (l *DataStreamLifecycle) Validate() error {
+	var invalid []string
+	if l.dataRetention != "" && !timeValuePattern.MatchString(l.dataRetention) {
+		invalid = append(invalid, "DataRetention")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (l *DataStreamLifecycle) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"lifecycle": {
+	// 		"data_retention": "7d",
+	// 		"enabled": true
+	// 	}
+	// }
+	options := make(map[string]interface{})
+
+	if l.dataRetention != "" {
+		options["data_retention"] = l.dataRetention
+	}
+	if l.enabled != nil {
+		options["enabled"] = l.enabled
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source["lifecycle"] = options
+	return source, nil
+}
+
+// DataStreamRollover body of the rollover API request `POST <data-stream>/_rollover`, which
+// creates a new write index for the data stream when any of the conditions is met, or
+// unconditionally when no condition is set.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.9/indices-rollover-index.html
+// for details.
+type DataStreamRollover struct {
+	maxAge              string
+	maxDocs             *int64
+	maxSize             string
+	maxPrimaryShardSize string
+	maxPrimaryShardDocs *int64
+}
+
+// NewDataStreamRollover initializes a new DataStreamRollover.
+func NewDataStreamRollover() *DataStreamRollover {
+	return &DataStreamRollover{}
+}
+
+// MaxAge sets the maximum elapsed time from the index creation, eg "7d".
+func (r *DataStreamRollover) MaxAge(maxAge string) *DataStreamRollover {
+	r.maxAge = maxAge
+	return r
+}
+
+// MaxDocs sets the maximum number of documents in the index.
+func (r *DataStreamRollover) MaxDocs(maxDocs int64) *DataStreamRollover {
+	r.maxDocs = &maxDocs
+	return r
+}
+
+// MaxSize sets the maximum size of all primary shards in the index, eg "50gb".
+func (r *DataStreamRollover) MaxSize(maxSize string) *DataStreamRollover {
+	r.maxSize = maxSize
+	return r
+}
+
+// MaxPrimaryShardSize sets the maximum size of the largest primary shard in the index,
+// eg "50gb".
+func (r *DataStreamRollover) MaxPrimaryShardSize(maxPrimaryShardSize string) *DataStreamRollover {
+	r.maxPrimaryShardSize = maxPrimaryShardSize
+	return r
+}
+
+// MaxPrimaryShardDocs sets the maximum number of documents in the largest primary shard in
+// the index.
+func (r *DataStreamRollover) MaxPrimaryShardDocs(maxPrimaryShardDocs int64) *DataStreamRollover {
+	r.maxPrimaryShardDocs = &maxPrimaryShardDocs
+	return r
+}
+
+// Validate validates DataStreamRollover.
+func (r *DataStreamRollover) Validate() error {
+	var invalid []string
+	if r.maxAge != "" && !timeValuePattern.MatchString(r.maxAge) {
+		invalid = append(invalid, "MaxAge")
+	}
+	if r.maxDocs != nil && *r.maxDocs <= 0 {
+		invalid = append(invalid, "MaxDocs")
+	}
+	if r.maxSize != "" && !byteSizeValuePattern.MatchString(r.maxSize) {
+		invalid = append(invalid, "MaxSize")
+	}
+	if r.maxPrimaryShardSize != "" && !byteSizeValuePattern.MatchString(r.maxPrimaryShardSize) {
+		invalid = append(invalid, "MaxPrimaryShardSize")
+	}
+	if r.maxPrimaryShardDocs != nil && *r.maxPrimaryShardDocs <= 0 {
+		invalid = append(invalid, "MaxPrimaryShardDocs")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (r *DataStreamRollover) Source() (interface{}, error) {
+	// {
+	// 	"conditions": {
+	// 		"max_age": "7d",
+	// 		"max_docs": 1000,
+	// 		"max_size": "50gb",
+	// 		"max_primary_shard_size": "50gb",
+	// 		"max_primary_shard_docs": 1000
+	// 	}
+	// }
+	conditions := make(map[string]interface{})
+
+	if r.maxAge != "" {
+		conditions["max_age"] = r.maxAge
+	}
+	if r.maxDocs != nil {
+		conditions["max_docs"] = r.maxDocs
+	}
+	if r.maxSize != "" {
+		conditions["max_size"] = r.maxSize
+	}
+	if r.maxPrimaryShardSize != "" {
+		conditions["max_primary_shard_size"] = r.maxPrimaryShardSize
+	}
+	if r.maxPrimaryShardDocs != nil {
+		conditions["max_primary_shard_docs"] = r.maxPrimaryShardDocs
+	}
+
+	source := make(map[string]interface{})
+	if len(conditions) > 0 {
+		source["conditions"] = conditions
+	}
+	return source, nil
+}
+
+// DataStreamModify body of the modify data stream API request `POST _data_stream/_modify`,
+// which atomically adds or removes backing indices of data streams.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.16/modify-data-streams-api.html
+// for details.
+type DataStreamModify struct {
+	actions []map[string]interface{}
+}
+
+// NewDataStreamModify initializes a new DataStreamModify.
+func NewDataStreamModify() *DataStreamModify {
+	return &DataStreamModify{
+		actions: make([]map[string]interface{}, 0),
+	}
+}
+
+// AddBackingIndex adds an action adding an existing index as a backing index of the data
+// stream.
+func (m *DataStreamModify) AddBackingIndex(dataStream, index string) *DataStreamModify {
+	return m.action("add_backing_index", dataStream, index)
+}
+
+// RemoveBackingIndex adds an action removing a backing index from the data stream, which
+// cannot be its write index.
+func (m *DataStreamModify) RemoveBackingIndex(dataStream, index string) *DataStreamModify {
+	return m.action("remove_backing_index", dataStream, index)
+}
+
+// action appends a named backing index action.
+func (m *DataStreamModify) action(name, dataStream, index string) *DataStreamModify {
+	m.actions = append(m.actions, map[string]interface{}{
+		name: map[string]interface{}{
+			"data_stream": dataStream,
+			"index":       index,
+		},
+	})
+	return m
+}
+
+// Validate validates DataStreamModify.
+func (m *DataStreamModify) Validate() error {
+	var invalid []string
+	if len(m.actions) == 0 {
+		invalid = append(invalid, "Actions")
+	}
+	for _, a := range m.actions {
+		for _, params := range a {
+			p := params.(map[string]interface{})
+			if p["data_stream"] == "" || p["index"] == "" {
+				invalid = append(invalid, "Actions")
+			}
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (m *DataStreamModify) Source() (interface{}, error) {
+	// {
+	// 	"actions": [
+	// 		{
+	// 			"remove_backing_index": {
+	// 				"data_stream": "my-logs",
+	// 				"index": ".ds-my-logs-2099.01.01-000001"
+	// 			}
+	// 		},
+	// 		{
+	// 			"add_backing_index": {
+	// 				"data_stream": "my-logs",
+	// 				"index": "index-to-add"
+	// 			}
+	// 		}
+	// 	]
+	// }
+	source := make(map[string]interface{})
+	source["actions"] = m.actions
+	return source, nil
+}
+
+// ValidateDataStream validates that the mappings can be used by the backing indices of a data
+// stream, which require the `@timestamp` field to be mapped as a `date` or `date_nanos`
+// datatype, and the `_data_stream_timestamp` meta-field to be enabled. The `@timestamp` field
+// is mapped as a `date` datatype by Elasticsearch when not set.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.9/set-up-a-data-stream.html
+// for details.
+func (m *Mappings) ValidateDataStream() error {
+	var invalid []string
+	if m.dataStreamTimestamp != nil && m.dataStreamTimestamp.enabled != nil && !*m.dataStreamTimestamp.enabled {
+		invalid = append(invalid, "meta field [_data_stream_timestamp] must be enabled")
+	}
+	for _, p := range m.properties {
+		if p.Name() != "@timestamp" {
+			continue
+		}
+		switch p.(type) {
+		case *DatatypeDate, *DatatypeDateNanoseconds:
+		default:
+			invalid = append(invalid, fmt.Sprintf("field [@timestamp] of type [%s] must be of type "+
+				"[date] or [date_nanos]", componentType(p)))
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid data stream mappings: %v", invalid)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDataStreamSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		d           *DataStream
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name without options.",
+			d:           NewDataStream(),
+			includeName: true,
+			expected:    `{"data_stream":{}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with Hidden and AllowCustomRouting.",
+			d:           NewDataStream().Hidden(true).AllowCustomRouting(false),
+			includeName: false,
+			expected:    `{"allow_custom_routing":false,"hidden":true}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.d.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestDataStreamLifecycleSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		l           *DataStreamLifecycle
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name with DataRetention.",
+			l:           NewDataStreamLifecycle().DataRetention("7d"),
+			includeName: true,
+			expected:    `{"lifecycle":{"data_retention":"7d"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with Enabled.",
+			l:           NewDataStreamLifecycle().Enabled(false),
+			includeName: false,
+			expected:    `{"enabled":false}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.l.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+	if err := NewDataStreamLifecycle().DataRetention("7 days").Validate(); err == nil {
+		t.Error("expected data retention validation error, got nil")
+	}
+}
+
+func TestDataStreamRolloverSerialization(t *testing.T) {
+	tests := []struct {
+		desc     string
+		r        *DataStreamRollover
+		expected string
+	}{
+		// #0
+		{
+			desc:     "Without conditions.",
+			r:        NewDataStreamRollover(),
+			expected: `{}`,
+		},
+		// #1
+		{
+			desc: "With all conditions.",
+			r: NewDataStreamRollover().MaxAge("7d").MaxDocs(1000).MaxSize("50gb").
+				MaxPrimaryShardSize("25gb").MaxPrimaryShardDocs(500),
+			expected: `{"conditions":{"max_age":"7d","max_docs":1000,"max_primary_shard_docs":500,"max_primary_shard_size":"25gb","max_size":"50gb"}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.r.Source()
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestDataStreamRolloverValidation(t *testing.T) {
+	tests := []struct {
+		desc  string
+		r     *DataStreamRollover
+		valid bool
+	}{
+		// #0
+		{
+			desc:  "Valid conditions.",
+			r:     NewDataStreamRollover().MaxAge("30d").MaxSize("1.5tb"),
+			valid: true,
+		},
+		// #1
+		{
+			desc:  "Invalid MaxAge.",
+			r:     NewDataStreamRollover().MaxAge("30 days"),
+			valid: false,
+		},
+		// #2
+		{
+			desc:  "Invalid MaxSize.",
+			r:     NewDataStreamRollover().MaxSize("50"),
+			valid: false,
+		},
+		// #3
+		{
+			desc:  "Non positive MaxDocs.",
+			r:     NewDataStreamRollover().MaxDocs(0),
+			valid: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.r.Validate()
+			if test.valid && err != nil {
+				t.Errorf("expected no error, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestDataStreamModifySerialization(t *testing.T) {
+	m := NewDataStreamModify().
+		RemoveBackingIndex("my-logs", ".ds-my-logs-2099.01.01-000001").
+		AddBackingIndex("my-logs", "index-to-add")
+	src, err := m.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	expected := `{"actions":[{"remove_backing_index":{"data_stream":"my-logs","index":".ds-my-logs-2099.01.01-000001"}},{"add_backing_index":{"data_stream":"my-logs","index":"index-to-add"}}]}`
+	if got := string(data); got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+	if err := m.Validate(); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+	if err := NewDataStreamModify().Validate(); err == nil {
+		t.Error("expected actions validation error, got nil")
+	}
+	if err := NewDataStreamModify().AddBackingIndex("my-logs", "").Validate(); err == nil {
+		t.Error("expected index validation error, got nil")
+	}
+}
+
+func TestMappingsValidateDataStream(t *testing.T) {
+	tests := []struct {
+		desc  string
+		m     *Mappings
+		valid bool
+	}{
+		// #0
+		{
+			desc:  "Without @timestamp.",
+			m:     NewMappings().Properties(NewDatatypeKeyword("message")),
+			valid: true,
+		},
+		// #1
+		{
+			desc:  "@timestamp as date.",
+			m:     NewMappings().Properties(NewDatatypeDate("@timestamp")),
+			valid: true,
+		},
+		// #2
+		{
+			desc:  "@timestamp as date_nanos.",
+			m:     NewMappings().Properties(NewDatatypeDateNanoseconds("@timestamp")),
+			valid: true,
+		},
+		// #3
+		{
+			desc:  "@timestamp as keyword.",
+			m:     NewMappings().Properties(NewDatatypeKeyword("@timestamp")),
+			valid: false,
+		},
+		// #4
+		{
+			desc: "Disabled _data_stream_timestamp.",
+			m: NewMappings().
+				DataStreamTimestamp(NewMetaFieldDataStreamTimestamp().Enabled(false)).
+				Properties(NewDatatypeDate("@timestamp")),
+			valid: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.m.ValidateDataStream()
+			if test.valid && err != nil {
+				t.Errorf("expected no error, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// DatatypeAggregateMetricDouble Specialised Datatype for pre-aggregated numeric values
+// for metric aggregations, stored as `min`, `max`, `sum` and `value_count` sub-fields.
+// Requires Elasticsearch 7.11 or later.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.11/aggregate-metric-double.html
+// for details.
+type DatatypeAggregateMetricDouble struct {
+	Datatype
+	name   string
+	copyTo []string
+
+	// fields specific to aggregate metric double datatype
+	metrics       []string
+	defaultMetric string
+}
+
+// NewDatatypeAggregateMetricDouble initializes a new DatatypeAggregateMetricDouble.
+func NewDatatypeAggregateMetricDouble(name string) *DatatypeAggregateMetricDouble {
+	return &DatatypeAggregateMetricDouble{
+		name:    name,
+		metrics: make([]string, 0),
+	}
+}
+
+// Name returns field key for the Datatype.
+func (a *DatatypeAggregateMetricDouble) Name() string {
+	return a.name
+}
+
+// MinimumVersion returns the minimum Elasticsearch version supporting the Datatype.
+func (a *DatatypeAggregateMetricDouble) MinimumVersion() string {
+	return "7.11.0"
+}
+
+// CopyTo sets the field(s) to copy to which allows the values of multiple fields to be
+// queried as a single field.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.11/copy-to.html
+// for details.
+func (a *DatatypeAggregateMetricDouble) CopyTo(copyTo ...string) *DatatypeAggregateMetricDouble {
+	a.copyTo = append(a.copyTo, copyTo...)
+	return a
+}
+
+// Metrics sets the metric sub-fields to store, which can be "min", "max", "sum" and
+// "value_count".
+func (a *DatatypeAggregateMetricDouble) Metrics(metrics ...string) *DatatypeAggregateMetricDouble {
+	a.metrics = append(a.metrics, metrics...)
+	return a
+}
+
+// DefaultMetric sets the default metric sub-field to use for queries, scripts and
+// aggregations that don't use a sub-field. Must be one of the metrics, and can be omitted
+// when a single metric is stored.
+func (a *DatatypeAggregateMetricDouble) DefaultMetric(defaultMetric string) *DatatypeAggregateMetricDouble {
+	a.defaultMetric = defaultMetric
+	return a
+}
+
+// Validate validates DatatypeAggregateMetricDouble.
+func (a *DatatypeAggregateMetricDouble) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && a.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	metrics := make(map[string]bool)
+	for _, m := range a.metrics {
+		if valid := map[string]bool{"min": true, "max": true, "sum": true, "value_count": true}[m]; !valid || metrics[m] {
+			invalid = append(invalid, "Metrics")
+			break
+		}
+		metrics[m] = true
+	}
+	if len(a.metrics) == 0 {
+		invalid = append(invalid, "Metrics")
+	}
+	if (a.defaultMetric == "" && len(a.metrics) > 1) || (a.defaultMetric != "" && !metrics[a.defaultMetric]) {
+		invalid = append(invalid, "DefaultMetric")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (a *DatatypeAggregateMetricDouble) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "aggregate_metric_double",
+	// 		"copy_to": ["field_1", "field_2"],
+	// 		"metrics": ["min", "max", "sum", "value_count"],
+	// 		"default_metric": "max"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "aggregate_metric_double"
+
+	if len(a.copyTo) > 0 {
+		var copyTo interface{}
+		switch {
+		case len(a.copyTo) > 1:
+			copyTo = a.copyTo
+			break
+		case len(a.copyTo) == 1:
+			copyTo = a.copyTo[0]
+			break
+		default:
+			copyTo = ""
+		}
+		options["copy_to"] = copyTo
+	}
+	if len(a.metrics) > 0 {
+		options["metrics"] = a.metrics
+	}
+	if a.defaultMetric != "" {
+		options["default_metric"] = a.defaultMetric
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[a.name] = options
+	return source, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDatatypeAggregateMetricDoubleSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		a           *DatatypeAggregateMetricDouble
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name with Metrics and DefaultMetric.",
+			a:           NewDatatypeAggregateMetricDouble("test").Metrics("min", "max").DefaultMetric("max"),
+			includeName: true,
+			expected:    `{"test":{"default_metric":"max","metrics":["min","max"],"type":"aggregate_metric_double"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with single Metrics.",
+			a:           NewDatatypeAggregateMetricDouble("test").Metrics("value_count"),
+			includeName: false,
+			expected:    `{"metrics":["value_count"],"type":"aggregate_metric_double"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.a.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestDatatypeAggregateMetricDoubleValidation(t *testing.T) {
+	tests := []struct {
+		desc  string
+		a     *DatatypeAggregateMetricDouble
+		valid bool
+	}{
+		// #0
+		{
+			desc:  "Single metric without default metric.",
+			a:     NewDatatypeAggregateMetricDouble("test").Metrics("sum"),
+			valid: true,
+		},
+		// #1
+		{
+			desc:  "Default metric in metrics.",
+			a:     NewDatatypeAggregateMetricDouble("test").Metrics("min", "max").DefaultMetric("min"),
+			valid: true,
+		},
+		// #2
+		{
+			desc:  "Default metric not in metrics.",
+			a:     NewDatatypeAggregateMetricDouble("test").Metrics("min", "max").DefaultMetric("sum"),
+			valid: false,
+		},
+		// #3
+		{
+			desc:  "Multiple metrics without default metric.",
+			a:     NewDatatypeAggregateMetricDouble("test").Metrics("min", "max"),
+			valid: false,
+		},
+		// #4
+		{
+			desc:  "Invalid metric.",
+			a:     NewDatatypeAggregateMetricDouble("test").Metrics("avg").DefaultMetric("avg"),
+			valid: false,
+		},
+		// #5
+		{
+			desc:  "Missing metrics.",
+			a:     NewDatatypeAggregateMetricDouble("test"),
+			valid: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.a.Validate(true)
+			if test.valid && err != nil {
+				t.Errorf("expected no error, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
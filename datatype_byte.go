@@ -4,7 +4,10 @@
 
 package estemplate
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
 // DatatypeByte Core Datatype for numeric value.
 // A signed 8-bit integer with a minimum value of -128 and a maximum value of 127.
@@ -17,13 +20,15 @@ type DatatypeByte struct {
 	copyTo []string
 
 	// fields specific to byte datatype
-	coerce          *bool
-	boost           *float32
-	docValues       *bool
-	ignoreMalformed *bool
-	index           *bool
-	nullValue       *int
-	store           *bool
+	coerce              *bool
+	boost               *float32
+	docValues           *bool
+	ignoreMalformed     *bool
+	index               *bool
+	nullValue           *int
+	store               *bool
+	timeSeriesDimension *bool
+	timeSeriesMetric    string
 }
 
 // NewDatatypeByte initializes a new DatatypeByte.
@@ -97,8 +102,8 @@ func (b *DatatypeByte) Index(index bool) *DatatypeByte {
 	return b
 }
 
-// NullValue sets a numeric value which is substituted for any explicit null values.
-// Defaults to null.
+// NullValue sets a numeric value which is substituted for any explicit null values,
+// within the byte range [-128, 127]. Defaults to null.
 //
 // See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/null-value.html
 // for details.
@@ -117,14 +122,46 @@ func (b *DatatypeByte) Store(store bool) *DatatypeByte {
 	return b
 }
 
+// TimeSeriesDimension sets whether the field is a time series dimension, identifying together
+// with the other dimensions the time series of the documents of `time_series` indices.
+// Defaults to false.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-dimension
+// for details.
+func (b *DatatypeByte) TimeSeriesDimension(timeSeriesDimension bool) *DatatypeByte {
+	b.timeSeriesDimension = &timeSeriesDimension
+	return b
+}
+
+// TimeSeriesMetric sets the time series metric type of the field, which can be set to "gauge"
+// or "counter". Metric fields cannot be time series dimensions.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-metric
+// for details.
+func (b *DatatypeByte) TimeSeriesMetric(timeSeriesMetric string) *DatatypeByte {
+	b.timeSeriesMetric = timeSeriesMetric
+	return b
+}
+
 // Validate validates DatatypeByte.
 func (b *DatatypeByte) Validate(includeName bool) error {
 	var invalid []string
 	if includeName && b.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if b.nullValue != nil && (*b.nullValue < math.MinInt8 || *b.nullValue > math.MaxInt8) {
+		invalid = append(invalid, "NullValue")
+	}
+	if b.timeSeriesMetric != "" {
+		if valid := validTimeSeriesMetrics[b.timeSeriesMetric]; !valid {
+			invalid = append(invalid, "TimeSeriesMetric")
+		}
+	}
+	if b.timeSeriesMetric != "" && b.timeSeriesDimension != nil && *b.timeSeriesDimension {
+		invalid = append(invalid, "TimeSeriesMetric")
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
@@ -141,7 +178,9 @@ func (b *DatatypeByte) Source(includeName bool) (interface{}, error) {
 	// 		"ignore_malformed": true,
 	// 		"index": true,
 	// 		"null_value": 0,
-	// 		"store": true
+	// 		"store": true,
+	// 		"time_series_dimension": true,
+	// 		"time_series_metric": "gauge"
 	// 	}
 	// }
 	options := make(map[string]interface{})
@@ -182,6 +221,12 @@ func (b *DatatypeByte) Source(includeName bool) (interface{}, error) {
 	if b.store != nil {
 		options["store"] = b.store
 	}
+	if b.timeSeriesDimension != nil {
+		options["time_series_dimension"] = b.timeSeriesDimension
+	}
+	if b.timeSeriesMetric != "" {
+		options["time_series_metric"] = b.timeSeriesMetric
+	}
 
 	if !includeName {
 		return options, nil
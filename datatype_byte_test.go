@@ -30,6 +30,13 @@ func TestDatatypeByteSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"coerce":true,"index":true,"type":"byte"}`,
 		},
+		// #2
+		{
+			desc:        "Exclude Name with NullValue.",
+			b:           NewDatatypeByte("test").NullValue(-128),
+			includeName: false,
+			expected:    `{"null_value":-128,"type":"byte"}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -46,4 +53,7 @@ func TestDatatypeByteSerialization(t *testing.T) {
 			}
 		})
 	}
+	if err := NewDatatypeByte("test").NullValue(128).Validate(true); err == nil {
+		t.Error("expected DatatypeByte validation error, got nil")
+	}
 }
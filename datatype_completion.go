@@ -21,12 +21,14 @@ type DatatypeCompletion struct {
 	preserveSeparators         *bool
 	preservePositionIncrements *bool
 	maxInputLength             *int
+	contexts                   []*CompletionContext
 }
 
 // NewDatatypeCompletion initializes a new DatatypeCompletion.
 func NewDatatypeCompletion(name string) *DatatypeCompletion {
 	return &DatatypeCompletion{
-		name: name,
+		name:     name,
+		contexts: make([]*CompletionContext, 0),
 	}
 }
 
@@ -84,14 +86,32 @@ func (c *DatatypeCompletion) MaxInputLength(maxInputLength int) *DatatypeComplet
 	return c
 }
 
+// Contexts sets the category and geo contexts of the suggestions, which can be used to filter
+// or boost suggestions at query time.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/suggester-context.html
+// for details.
+func (c *DatatypeCompletion) Contexts(contexts ...*CompletionContext) *DatatypeCompletion {
+	c.contexts = append(c.contexts, contexts...)
+	return c
+}
+
 // Validate validates DatatypeCompletion.
 func (c *DatatypeCompletion) Validate(includeName bool) error {
 	var invalid []string
 	if includeName && c.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	names := make(map[string]bool)
+	for _, ctx := range c.contexts {
+		if err := ctx.Validate(); err != nil || names[ctx.name] {
+			invalid = append(invalid, "Contexts")
+			break
+		}
+		names[ctx.name] = true
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
@@ -106,7 +126,14 @@ func (c *DatatypeCompletion) Source(includeName bool) (interface{}, error) {
 	// 		"search_analyzer": "standard",
 	// 		"preserve_separators": true,
 	// 		"preserve_position_increments": true,
-	// 		"max_input_length": 50
+	// 		"max_input_length": 50,
+	// 		"contexts": [
+	// 			{
+	// 				"name": "place_type",
+	// 				"type": "category",
+	// 				"path": "cat"
+	// 			}
+	// 		]
 	// 	}
 	// }
 	options := make(map[string]interface{})
@@ -141,6 +168,17 @@ func (c *DatatypeCompletion) Source(includeName bool) (interface{}, error) {
 	if c.maxInputLength != nil {
 		options["max_input_length"] = c.maxInputLength
 	}
+	if len(c.contexts) > 0 {
+		contexts := make([]interface{}, 0)
+		for _, ctx := range c.contexts {
+			context, err := ctx.Source()
+			if err != nil {
+				return nil, err
+			}
+			contexts = append(contexts, context)
+		}
+		options["contexts"] = contexts
+	}
 
 	if !includeName {
 		return options, nil
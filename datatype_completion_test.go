@@ -30,6 +30,13 @@ func TestDatatypeCompletionSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"max_input_length":100,"preserve_position_increments":true,"type":"completion"}`,
 		},
+		// #2
+		{
+			desc:        "Exclude Name with Contexts.",
+			c:           NewDatatypeCompletion("test").Contexts(NewCompletionContextCategory("place_type"), NewCompletionContextGeo("location").Precision(4)),
+			includeName: false,
+			expected:    `{"contexts":[{"name":"place_type","type":"category"},{"name":"location","precision":4,"type":"geo"}],"type":"completion"}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -46,4 +53,7 @@ func TestDatatypeCompletionSerialization(t *testing.T) {
 			}
 		})
 	}
+	if err := NewDatatypeCompletion("test").Contexts(NewCompletionContextCategory("place_type"), NewCompletionContextGeo("place_type")).Validate(true); err == nil {
+		t.Error("expected DatatypeCompletion validation error, got nil")
+	}
 }
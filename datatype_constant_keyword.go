@@ -0,0 +1,106 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// DatatypeConstantKeyword Core Datatype for keyword fields which always contain
+// the same value for all documents in the index. Requires Elasticsearch 7.7 or later.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.7/constant-keyword.html
+// for details.
+type DatatypeConstantKeyword struct {
+	Datatype
+	name   string
+	copyTo []string
+
+	// fields specific to constant keyword datatype
+	value string
+}
+
+// NewDatatypeConstantKeyword initializes a new DatatypeConstantKeyword.
+func NewDatatypeConstantKeyword(name string) *DatatypeConstantKeyword {
+	return &DatatypeConstantKeyword{
+		name: name,
+	}
+}
+
+// Name returns field key for the Datatype.
+func (k *DatatypeConstantKeyword) Name() string {
+	return k.name
+}
+
+// MinimumVersion returns the minimum Elasticsearch version supporting the Datatype.
+func (k *DatatypeConstantKeyword) MinimumVersion() string {
+	return "7.7.0"
+}
+
+// CopyTo sets the field(s) to copy to which allows the values of multiple fields to be
+// queried as a single field.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.7/copy-to.html
+// for details.
+func (k *DatatypeConstantKeyword) CopyTo(copyTo ...string) *DatatypeConstantKeyword {
+	k.copyTo = append(k.copyTo, copyTo...)
+	return k
+}
+
+// Value sets the value to associate with all documents in the index. If not set, it is
+// set based on the first document that gets indexed.
+func (k *DatatypeConstantKeyword) Value(value string) *DatatypeConstantKeyword {
+	k.value = value
+	return k
+}
+
+// Validate validates DatatypeConstantKeyword.
+func (k *DatatypeConstantKeyword) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && k.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (k *DatatypeConstantKeyword) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "constant_keyword",
+	// 		"copy_to": ["field_1", "field_2"],
+	// 		"value": "debug"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "constant_keyword"
+
+	if len(k.copyTo) > 0 {
+		var copyTo interface{}
+		switch {
+		case len(k.copyTo) > 1:
+			copyTo = k.copyTo
+			break
+		case len(k.copyTo) == 1:
+			copyTo = k.copyTo[0]
+			break
+		default:
+			copyTo = ""
+		}
+		options["copy_to"] = copyTo
+	}
+	if k.value != "" {
+		options["value"] = k.value
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[k.name] = options
+	return source, nil
+}
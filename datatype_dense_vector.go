@@ -6,10 +6,11 @@ package estemplate
 
 import "fmt"
 
-// DatatypeDenseVector Specialised Datatype that stores dense vectors of float
-// values. The maximum number of dimensions that can be in a vector should not exceed
-// 1024.
-// ! Experimental and may be changed or removed completely in a future release.
+// DatatypeDenseVector Specialised Datatype that stores dense vectors of float or byte
+// values. Indexed vectors can be searched with approximate kNN search since
+// Elasticsearch 8.0. The maximum number of dimensions that can be in a vector should
+// not exceed 4096, or lower in older Elasticsearch versions, see denseVectorMaxDims and
+// Mappings.ValidateVersion.
 //
 // See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/dense-vector.html
 // for details.
@@ -19,7 +20,30 @@ type DatatypeDenseVector struct {
 	copyTo []string
 
 	// fields specific to dense vector datatype
-	dims *int
+	dims         *int
+	elementType  string
+	index        *bool
+	similarity   string
+	indexOptions *DenseVectorIndexOptions
+}
+
+// maxDenseVectorDims maximum number of dimensions of a dense vector in the latest Elasticsearch
+// versions. Older versions have lower limits, see denseVectorMaxDims.
+const maxDenseVectorDims = 4096
+
+// denseVectorMaxDims returns the maximum number of dimensions of a dense vector in the given
+// Elasticsearch version, which was raised from 1024 to 2048 in 7.6 and to 4096 in 8.10.
+// Indexed vectors were limited to 1024 dimensions until 8.8.
+func denseVectorMaxDims(version esVersion, indexed bool) int {
+	switch {
+	case version.less(esVersion{7, 6, 0}):
+		return 1024
+	case indexed && version.less(esVersion{8, 8, 0}):
+		return 1024
+	case version.less(esVersion{8, 10, 0}):
+		return 2048
+	}
+	return maxDenseVectorDims
 }
 
 // NewDatatypeDenseVector initializes a new DatatypeDenseVector.
@@ -46,21 +70,103 @@ func (v *DatatypeDenseVector) CopyTo(copyTo ...string) *DatatypeDenseVector {
 
 // Dims sets the number of dimensions in the vector. Internally, each document's dense
 // vector is encoded as a binary doc value. Its size in bytes is equal to 4 * dims + 4,
-// where dims - the number of the vector's dimensions.
+// where dims - the number of the vector's dimensions. Must be between 1 and 4096, or lower
+// in older Elasticsearch versions, see Mappings.ValidateVersion.
 func (v *DatatypeDenseVector) Dims(dims int) *DatatypeDenseVector {
 	v.dims = &dims
 	return v
 }
 
+// ElementType sets the data type used to encode vectors, which can be set to "float", 4
+// bytes per dimension, or "byte", 1 byte per dimension with integer values between -128
+// and 127. Defaults to "float".
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.12/dense-vector.html#dense-vector-params
+// for details.
+func (v *DatatypeDenseVector) ElementType(elementType string) *DatatypeDenseVector {
+	v.elementType = elementType
+	return v
+}
+
+// Index sets whether the vectors should be indexed for approximate kNN search. Defaults to
+// true since Elasticsearch 8.11, false before.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.12/dense-vector.html#index-vectors-knn-search
+// for details.
+func (v *DatatypeDenseVector) Index(index bool) *DatatypeDenseVector {
+	v.index = &index
+	return v
+}
+
+// Similarity sets the vector similarity metric to use in kNN search, which can be set
+// to "l2_norm", "dot_product", "cosine" or "max_inner_product". Requires the vectors to
+// be indexed, ie Index(true) before Elasticsearch 8.11. Defaults to "cosine".
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.12/dense-vector.html#dense-vector-similarity
+// for details.
+func (v *DatatypeDenseVector) Similarity(similarity string) *DatatypeDenseVector {
+	v.similarity = similarity
+	return v
+}
+
+// IndexOptions sets the kNN indexing algorithm options. Requires the vectors to be indexed,
+// ie Index(true) before Elasticsearch 8.11.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.12/dense-vector.html#dense-vector-index-options
+// for details.
+func (v *DatatypeDenseVector) IndexOptions(indexOptions *DenseVectorIndexOptions) *DatatypeDenseVector {
+	v.indexOptions = indexOptions
+	return v
+}
+
+// MinimumVersion returns the minimum Elasticsearch version supporting the Datatype with its
+// options.
+func (v *DatatypeDenseVector) MinimumVersion() string {
+	switch {
+	case v.indexOptions != nil && v.indexOptions.typ == "int8_hnsw":
+		return "8.12.0"
+	case v.similarity == "max_inner_product":
+		return "8.11.0"
+	case v.elementType != "":
+		return "8.6.0"
+	case v.index != nil || v.similarity != "" || v.indexOptions != nil:
+		return "8.0.0"
+	}
+	return "7.0.0"
+}
+
 // Validate validates DatatypeDenseVector.
 func (v *DatatypeDenseVector) Validate(includeName bool) error {
 	var invalid []string
 	if includeName && v.name == "" {
 		invalid = append(invalid, "Name")
 	}
-	// TODO: validate dims
+	elementType := v.elementType
+	if elementType == "" {
+		elementType = "float"
+	}
+	if valid := map[string]bool{"float": true, "byte": true}[elementType]; !valid {
+		invalid = append(invalid, "ElementType")
+	}
+	if v.dims != nil && (*v.dims < 1 || *v.dims > maxDenseVectorDims) {
+		invalid = append(invalid, "Dims")
+	}
+	// vectors are indexed by default since Elasticsearch 8.11, older versions are validated
+	// by Mappings.ValidateVersion.
+	indexed := v.index == nil || *v.index
+	if v.similarity != "" {
+		if valid := map[string]bool{"l2_norm": true, "dot_product": true, "cosine": true, "max_inner_product": true}[v.similarity]; !valid || !indexed {
+			invalid = append(invalid, "Similarity")
+		}
+	}
+	if v.indexOptions != nil {
+		// int8 quantization only applies to float vectors.
+		if err := v.indexOptions.Validate(); err != nil || !indexed || (v.indexOptions.typ == "int8_hnsw" && elementType != "float") {
+			invalid = append(invalid, "IndexOptions")
+		}
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
@@ -71,7 +177,15 @@ func (v *DatatypeDenseVector) Source(includeName bool) (interface{}, error) {
 	// 	"test": {
 	// 		"type": "dense_vector",
 	// 		"copy_to": ["field_1", "field_2"],
-	// 		"dims": 3
+	// 		"dims": 3,
+	// 		"element_type": "float",
+	// 		"index": true,
+	// 		"similarity": "dot_product",
+	// 		"index_options": {
+	// 			"type": "hnsw",
+	// 			"m": 16,
+	// 			"ef_construction": 100
+	// 		}
 	// 	}
 	// }
 	options := make(map[string]interface{})
@@ -94,6 +208,22 @@ func (v *DatatypeDenseVector) Source(includeName bool) (interface{}, error) {
 	if v.dims != nil {
 		options["dims"] = v.dims
 	}
+	if v.elementType != "" {
+		options["element_type"] = v.elementType
+	}
+	if v.index != nil {
+		options["index"] = v.index
+	}
+	if v.similarity != "" {
+		options["similarity"] = v.similarity
+	}
+	if v.indexOptions != nil {
+		indexOptions, err := v.indexOptions.Source(false)
+		if err != nil {
+			return nil, err
+		}
+		options["index_options"] = indexOptions
+	}
 
 	if !includeName {
 		return options, nil
@@ -30,6 +30,14 @@ func TestDatatypeDenseVectorSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"type":"dense_vector"}`,
 		},
+		// #2
+		{
+			desc: "Exclude Name with kNN options.",
+			v: NewDatatypeDenseVector("test").Dims(384).ElementType("float").Index(true).Similarity("dot_product").
+				IndexOptions(NewDenseVectorIndexOptions("int8_hnsw").M(32).EfConstruction(200)),
+			includeName: false,
+			expected:    `{"dims":384,"element_type":"float","index":true,"index_options":{"ef_construction":200,"m":32,"type":"int8_hnsw"},"similarity":"dot_product","type":"dense_vector"}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -47,3 +55,71 @@ func TestDatatypeDenseVectorSerialization(t *testing.T) {
 		})
 	}
 }
+
+func TestDatatypeDenseVectorValidation(t *testing.T) {
+	tests := []struct {
+		desc  string
+		v     *DatatypeDenseVector
+		valid bool
+	}{
+		// #0
+		{
+			desc:  "Indexed byte vector.",
+			v:     NewDatatypeDenseVector("test").Dims(4096).ElementType("byte").Index(true).Similarity("cosine").IndexOptions(NewDenseVectorIndexOptions("hnsw")),
+			valid: true,
+		},
+		// #1
+		{
+			desc:  "Too many dims.",
+			v:     NewDatatypeDenseVector("test").Dims(4097),
+			valid: false,
+		},
+		// #2
+		{
+			desc:  "Invalid element type.",
+			v:     NewDatatypeDenseVector("test").Dims(3).ElementType("half_float"),
+			valid: false,
+		},
+		// #3
+		{
+			desc:  "Similarity without index.",
+			v:     NewDatatypeDenseVector("test").Dims(3).Index(false).Similarity("l2_norm"),
+			valid: false,
+		},
+		// #4
+		{
+			desc:  "Quantized byte vector.",
+			v:     NewDatatypeDenseVector("test").Dims(3).ElementType("byte").Index(true).IndexOptions(NewDenseVectorIndexOptions("int8_hnsw")),
+			valid: false,
+		},
+		// #5
+		{
+			desc:  "Invalid index options.",
+			v:     NewDatatypeDenseVector("test").Dims(3).Index(true).IndexOptions(NewDenseVectorIndexOptions("hnsw").M(1024)),
+			valid: false,
+		},
+		// #6
+		{
+			desc:  "Similarity and index options indexed by default.",
+			v:     NewDatatypeDenseVector("test").Dims(3).Similarity("cosine").IndexOptions(NewDenseVectorIndexOptions("hnsw")),
+			valid: true,
+		},
+		// #7
+		{
+			desc:  "Index options without index.",
+			v:     NewDatatypeDenseVector("test").Dims(3).Index(false).IndexOptions(NewDenseVectorIndexOptions("hnsw")),
+			valid: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.v.Validate(true)
+			if test.valid && err != nil {
+				t.Errorf("expected no error, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
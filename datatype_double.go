@@ -17,13 +17,14 @@ type DatatypeDouble struct {
 	copyTo []string
 
 	// fields specific to double datatype
-	coerce          *bool
-	boost           *float32
-	docValues       *bool
-	ignoreMalformed *bool
-	index           *bool
-	nullValue       *int
-	store           *bool
+	coerce           *bool
+	boost            *float32
+	docValues        *bool
+	ignoreMalformed  *bool
+	index            *bool
+	nullValue        *float64
+	store            *bool
+	timeSeriesMetric string
 }
 
 // NewDatatypeDouble initializes a new DatatypeDouble.
@@ -102,7 +103,7 @@ func (d *DatatypeDouble) Index(index bool) *DatatypeDouble {
 // Defaults to null.
 // See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/null-value.html
 // for details.
-func (d *DatatypeDouble) NullValue(nullValue int) *DatatypeDouble {
+func (d *DatatypeDouble) NullValue(nullValue float64) *DatatypeDouble {
 	d.nullValue = &nullValue
 	return d
 }
@@ -117,14 +118,32 @@ func (d *DatatypeDouble) Store(store bool) *DatatypeDouble {
 	return d
 }
 
+// TimeSeriesMetric sets the time series metric type of the field, which can be set to "gauge"
+// or "counter". Metric fields cannot be time series dimensions.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-metric
+// for details.
+func (d *DatatypeDouble) TimeSeriesMetric(timeSeriesMetric string) *DatatypeDouble {
+	d.timeSeriesMetric = timeSeriesMetric
+	return d
+}
+
 // Validate validates DatatypeDouble.
 func (d *DatatypeDouble) Validate(includeName bool) error {
 	var invalid []string
 	if includeName && d.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if d.nullValue != nil && !isFinite(*d.nullValue) {
+		invalid = append(invalid, "NullValue")
+	}
+	if d.timeSeriesMetric != "" {
+		if valid := validTimeSeriesMetrics[d.timeSeriesMetric]; !valid {
+			invalid = append(invalid, "TimeSeriesMetric")
+		}
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
@@ -141,7 +160,8 @@ func (d *DatatypeDouble) Source(includeName bool) (interface{}, error) {
 	// 		"ignore_malformed": true,
 	// 		"index": true,
 	// 		"null_value": 0,
-	// 		"store": true
+	// 		"store": true,
+	// 		"time_series_metric": "gauge"
 	// 	}
 	// }
 	options := make(map[string]interface{})
@@ -182,6 +202,9 @@ func (d *DatatypeDouble) Source(includeName bool) (interface{}, error) {
 	if d.store != nil {
 		options["store"] = d.store
 	}
+	if d.timeSeriesMetric != "" {
+		options["time_series_metric"] = d.timeSeriesMetric
+	}
 
 	if !includeName {
 		return options, nil
@@ -6,6 +6,7 @@ package estemplate
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 )
 
@@ -30,6 +31,20 @@ func TestDatatypeDoubleSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"coerce":true,"index":true,"type":"double"}`,
 		},
+		// #2
+		{
+			desc:        "Exclude Name with NullValue.",
+			d:           NewDatatypeDouble("test").NullValue(0.5),
+			includeName: false,
+			expected:    `{"null_value":0.5,"type":"double"}`,
+		},
+		// #3
+		{
+			desc:        "Exclude Name with TimeSeriesMetric.",
+			d:           NewDatatypeDouble("test").TimeSeriesMetric("gauge"),
+			includeName: false,
+			expected:    `{"time_series_metric":"gauge","type":"double"}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -46,4 +61,7 @@ func TestDatatypeDoubleSerialization(t *testing.T) {
 			}
 		})
 	}
+	if err := NewDatatypeDouble("test").NullValue(math.NaN()).Validate(true); err == nil {
+		t.Error("expected DatatypeDouble validation error, got nil")
+	}
 }
@@ -17,13 +17,14 @@ type DatatypeFloat struct {
 	copyTo []string
 
 	// fields specific to float datatype
-	coerce          *bool
-	boost           *float32
-	docValues       *bool
-	ignoreMalformed *bool
-	index           *bool
-	nullValue       *int
-	store           *bool
+	coerce           *bool
+	boost            *float32
+	docValues        *bool
+	ignoreMalformed  *bool
+	index            *bool
+	nullValue        *float32
+	store            *bool
+	timeSeriesMetric string
 }
 
 // NewDatatypeFloat initializes a new DatatypeFloat.
@@ -102,7 +103,7 @@ func (f *DatatypeFloat) Index(index bool) *DatatypeFloat {
 //
 // See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/null-value.html
 // for details.
-func (f *DatatypeFloat) NullValue(nullValue int) *DatatypeFloat {
+func (f *DatatypeFloat) NullValue(nullValue float32) *DatatypeFloat {
 	f.nullValue = &nullValue
 	return f
 }
@@ -117,14 +118,32 @@ func (f *DatatypeFloat) Store(store bool) *DatatypeFloat {
 	return f
 }
 
+// TimeSeriesMetric sets the time series metric type of the field, which can be set to "gauge"
+// or "counter". Metric fields cannot be time series dimensions.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-metric
+// for details.
+func (f *DatatypeFloat) TimeSeriesMetric(timeSeriesMetric string) *DatatypeFloat {
+	f.timeSeriesMetric = timeSeriesMetric
+	return f
+}
+
 // Validate validates DatatypeFloat.
 func (f *DatatypeFloat) Validate(includeName bool) error {
 	var invalid []string
 	if includeName && f.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if f.nullValue != nil && !isFinite(float64(*f.nullValue)) {
+		invalid = append(invalid, "NullValue")
+	}
+	if f.timeSeriesMetric != "" {
+		if valid := validTimeSeriesMetrics[f.timeSeriesMetric]; !valid {
+			invalid = append(invalid, "TimeSeriesMetric")
+		}
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
@@ -141,7 +160,8 @@ func (f *DatatypeFloat) Source(includeName bool) (interface{}, error) {
 	// 		"ignore_malformed": true,
 	// 		"index": true,
 	// 		"null_value": 0,
-	// 		"store": true
+	// 		"store": true,
+	// 		"time_series_metric": "gauge"
 	// 	}
 	// }
 	options := make(map[string]interface{})
@@ -182,6 +202,9 @@ func (f *DatatypeFloat) Source(includeName bool) (interface{}, error) {
 	if f.store != nil {
 		options["store"] = f.store
 	}
+	if f.timeSeriesMetric != "" {
+		options["time_series_metric"] = f.timeSeriesMetric
+	}
 
 	if !includeName {
 		return options, nil
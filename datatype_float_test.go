@@ -30,6 +30,13 @@ func TestDatatypeFloatSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"coerce":true,"index":true,"type":"float"}`,
 		},
+		// #2
+		{
+			desc:        "Exclude Name with NullValue.",
+			f:           NewDatatypeFloat("test").NullValue(0.1),
+			includeName: false,
+			expected:    `{"null_value":0.1,"type":"float"}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -17,13 +17,14 @@ type DatatypeHalfFloat struct {
 	copyTo []string
 
 	// fields specific to half float datatype
-	coerce          *bool
-	boost           *float32
-	docValues       *bool
-	ignoreMalformed *bool
-	index           *bool
-	nullValue       *int
-	store           *bool
+	coerce           *bool
+	boost            *float32
+	docValues        *bool
+	ignoreMalformed  *bool
+	index            *bool
+	nullValue        *float64
+	store            *bool
+	timeSeriesMetric string
 }
 
 // NewDatatypeHalfFloat initializes a new DatatypeHalfFloat.
@@ -97,12 +98,12 @@ func (hf *DatatypeHalfFloat) Index(index bool) *DatatypeHalfFloat {
 	return hf
 }
 
-// NullValue sets a numeric value which is substituted for any explicit null values.
-// Defaults to null.
+// NullValue sets a numeric value which is substituted for any explicit null values,
+// rounded to the closest half-precision value, see PrecisionWarnings. Defaults to null.
 //
 // See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/null-value.html
 // for details.
-func (hf *DatatypeHalfFloat) NullValue(nullValue int) *DatatypeHalfFloat {
+func (hf *DatatypeHalfFloat) NullValue(nullValue float64) *DatatypeHalfFloat {
 	hf.nullValue = &nullValue
 	return hf
 }
@@ -117,14 +118,48 @@ func (hf *DatatypeHalfFloat) Store(store bool) *DatatypeHalfFloat {
 	return hf
 }
 
+// PrecisionWarnings returns warnings for the values which cannot be represented exactly as
+// half-precision floats, including the null value, as half floats only keep 11 significant
+// bits, ie about 3 decimal digits, and a maximum value of 65504.
+func (hf *DatatypeHalfFloat) PrecisionWarnings(values ...float64) []string {
+	if hf.nullValue != nil {
+		values = append([]float64{*hf.nullValue}, values...)
+	}
+	var warnings []string
+	for _, v := range values {
+		if stored := halfFloatValue(v); stored != v {
+			warnings = append(warnings, fmt.Sprintf("value %v is stored as %v by half_float field [%s]", v, stored, hf.name))
+		}
+	}
+	return warnings
+}
+
+// TimeSeriesMetric sets the time series metric type of the field, which can be set to "gauge"
+// or "counter". Metric fields cannot be time series dimensions.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-metric
+// for details.
+func (hf *DatatypeHalfFloat) TimeSeriesMetric(timeSeriesMetric string) *DatatypeHalfFloat {
+	hf.timeSeriesMetric = timeSeriesMetric
+	return hf
+}
+
 // Validate validates DatatypeHalfFloat.
 func (hf *DatatypeHalfFloat) Validate(includeName bool) error {
 	var invalid []string
 	if includeName && hf.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if hf.nullValue != nil && !isFinite(halfFloatValue(*hf.nullValue)) {
+		invalid = append(invalid, "NullValue")
+	}
+	if hf.timeSeriesMetric != "" {
+		if valid := validTimeSeriesMetrics[hf.timeSeriesMetric]; !valid {
+			invalid = append(invalid, "TimeSeriesMetric")
+		}
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
@@ -141,7 +176,8 @@ func (hf *DatatypeHalfFloat) Source(includeName bool) (interface{}, error) {
 	// 		"ignore_malformed": true,
 	// 		"index": true,
 	// 		"null_value": 0,
-	// 		"store": true
+	// 		"store": true,
+	// 		"time_series_metric": "gauge"
 	// 	}
 	// }
 	options := make(map[string]interface{})
@@ -182,6 +218,9 @@ func (hf *DatatypeHalfFloat) Source(includeName bool) (interface{}, error) {
 	if hf.store != nil {
 		options["store"] = hf.store
 	}
+	if hf.timeSeriesMetric != "" {
+		options["time_series_metric"] = hf.timeSeriesMetric
+	}
 
 	if !includeName {
 		return options, nil
@@ -6,6 +6,7 @@ package estemplate
 
 import (
 	"encoding/json"
+	"reflect"
 	"testing"
 )
 
@@ -30,6 +31,13 @@ func TestDatatypeHalfFloatSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"coerce":true,"index":true,"type":"half_float"}`,
 		},
+		// #2
+		{
+			desc:        "Exclude Name with NullValue.",
+			hf:          NewDatatypeHalfFloat("test").NullValue(0.5),
+			includeName: false,
+			expected:    `{"null_value":0.5,"type":"half_float"}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -46,4 +54,19 @@ func TestDatatypeHalfFloatSerialization(t *testing.T) {
 			}
 		})
 	}
+	if err := NewDatatypeHalfFloat("test").NullValue(70000).Validate(true); err == nil {
+		t.Error("expected DatatypeHalfFloat validation error, got nil")
+	}
+}
+
+func TestDatatypeHalfFloatPrecisionWarnings(t *testing.T) {
+	hf := NewDatatypeHalfFloat("test").NullValue(0.1)
+	warnings := hf.PrecisionWarnings(0.5, 2049, 65504)
+	expected := []string{
+		"value 0.1 is stored as 0.0999755859375 by half_float field [test]",
+		"value 2049 is stored as 2048 by half_float field [test]",
+	}
+	if !reflect.DeepEqual(warnings, expected) {
+		t.Errorf("expected\n%v\n,got:\n%v", expected, warnings)
+	}
 }
@@ -0,0 +1,110 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// DatatypeHistogram Specialised Datatype for pre-aggregated numerical data representing
+// a histogram, defined by an array of `values` and an array of `counts`. Requires
+// Elasticsearch 7.6 or later.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.6/histogram.html
+// for details.
+type DatatypeHistogram struct {
+	Datatype
+	name   string
+	copyTo []string
+
+	// fields specific to histogram datatype
+	ignoreMalformed *bool
+}
+
+// NewDatatypeHistogram initializes a new DatatypeHistogram.
+func NewDatatypeHistogram(name string) *DatatypeHistogram {
+	return &DatatypeHistogram{
+		name: name,
+	}
+}
+
+// Name returns field key for the Datatype.
+func (h *DatatypeHistogram) Name() string {
+	return h.name
+}
+
+// MinimumVersion returns the minimum Elasticsearch version supporting the Datatype.
+func (h *DatatypeHistogram) MinimumVersion() string {
+	return "7.6.0"
+}
+
+// CopyTo sets the field(s) to copy to which allows the values of multiple fields to be
+// queried as a single field.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.6/copy-to.html
+// for details.
+func (h *DatatypeHistogram) CopyTo(copyTo ...string) *DatatypeHistogram {
+	h.copyTo = append(h.copyTo, copyTo...)
+	return h
+}
+
+// IgnoreMalformed sets whether if the field should ignore malformed histograms.
+// Defaults to false.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.6/ignore-malformed.html
+// for details.
+func (h *DatatypeHistogram) IgnoreMalformed(ignoreMalformed bool) *DatatypeHistogram {
+	h.ignoreMalformed = &ignoreMalformed
+	return h
+}
+
+// Validate validates DatatypeHistogram.
+func (h *DatatypeHistogram) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && h.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (h *DatatypeHistogram) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "histogram",
+	// 		"copy_to": ["field_1", "field_2"],
+	// 		"ignore_malformed": true
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "histogram"
+
+	if len(h.copyTo) > 0 {
+		var copyTo interface{}
+		switch {
+		case len(h.copyTo) > 1:
+			copyTo = h.copyTo
+			break
+		case len(h.copyTo) == 1:
+			copyTo = h.copyTo[0]
+			break
+		default:
+			copyTo = ""
+		}
+		options["copy_to"] = copyTo
+	}
+	if h.ignoreMalformed != nil {
+		options["ignore_malformed"] = h.ignoreMalformed
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[h.name] = options
+	return source, nil
+}
@@ -0,0 +1,382 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// DatatypeICUCollationKeyword (Plugin) Specialised Datatype that indexes the collation key of the
+// value as a single token, so that it can be used for language-sensitive sorting and range
+// queries on the raw value. Typically used within a multi-field.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-icu-collation-keyword-field.html
+// for details.
+type DatatypeICUCollationKeyword struct {
+	Datatype
+	name   string
+	copyTo []string
+
+	// fields specific to icu collation keyword datatype
+	docValues              *bool
+	fields                 []Datatype
+	ignoreAbove            *int
+	index                  *bool
+	nullValue              string
+	store                  *bool
+	language               string
+	country                string
+	variant                string
+	rules                  string
+	strength               string
+	decomposition          string
+	alternate              string
+	caseLevel              *bool
+	caseFirst              string
+	numeric                *bool
+	variableTop            string
+	hiraganaQuaternaryMode *bool
+}
+
+// NewDatatypeICUCollationKeyword initializes a new DatatypeICUCollationKeyword.
+func NewDatatypeICUCollationKeyword(name string) *DatatypeICUCollationKeyword {
+	return &DatatypeICUCollationKeyword{
+		name:   name,
+		fields: make([]Datatype, 0),
+	}
+}
+
+// Name returns field key for the Datatype.
+func (k *DatatypeICUCollationKeyword) Name() string {
+	return k.name
+}
+
+// CopyTo sets the field(s) to copy to which allows the values of multiple fields to be
+// queried as a single field.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/copy-to.html
+// for details.
+func (k *DatatypeICUCollationKeyword) CopyTo(copyTo ...string) *DatatypeICUCollationKeyword {
+	k.copyTo = append(k.copyTo, copyTo...)
+	return k
+}
+
+// DocValues sets whether if the field should be stored on disk in a column-stride fashion
+// so that it can later be used for sorting, aggregations, or scripting.
+// Defaults to true.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/doc-values.html
+// for details.
+func (k *DatatypeICUCollationKeyword) DocValues(docValues bool) *DatatypeICUCollationKeyword {
+	k.docValues = &docValues
+	return k
+}
+
+// Fields sets multi-fields which allow the same string value to be indexed in multiple
+// ways for different purposes.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/multi-fields.html
+// for details.
+func (k *DatatypeICUCollationKeyword) Fields(fields ...Datatype) *DatatypeICUCollationKeyword {
+	k.fields = append(k.fields, fields...)
+	return k
+}
+
+// IgnoreAbove sets the limit for the string length to be indexed, strings longer than
+// the `ignore_above` setting will not be indexed or stored.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/ignore-above.html
+// for details.
+func (k *DatatypeICUCollationKeyword) IgnoreAbove(ignoreAbove int) *DatatypeICUCollationKeyword {
+	k.ignoreAbove = &ignoreAbove
+	return k
+}
+
+// Index sets whether if the field should be searchable. Defaults to true.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/mapping-index.html
+// for details.
+func (k *DatatypeICUCollationKeyword) Index(index bool) *DatatypeICUCollationKeyword {
+	k.index = &index
+	return k
+}
+
+// NullValue sets a string value which is substituted for any explicit null values.
+// Defaults to null.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/null-value.html
+// for details.
+func (k *DatatypeICUCollationKeyword) NullValue(nullValue string) *DatatypeICUCollationKeyword {
+	k.nullValue = nullValue
+	return k
+}
+
+// Store sets whether if the field value should be stored and retrievable separately
+// from the `_source` field. Defaults to false.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/mapping-store.html
+// for details.
+func (k *DatatypeICUCollationKeyword) Store(store bool) *DatatypeICUCollationKeyword {
+	k.store = &store
+	return k
+}
+
+// Language sets the language of the locale used for collation, eg "de". Ignored when `rules`
+// is set.
+func (k *DatatypeICUCollationKeyword) Language(language string) *DatatypeICUCollationKeyword {
+	k.language = language
+	return k
+}
+
+// Country sets the country of the locale used for collation, eg "DE". Ignored when `rules`
+// is set.
+func (k *DatatypeICUCollationKeyword) Country(country string) *DatatypeICUCollationKeyword {
+	k.country = country
+	return k
+}
+
+// Variant sets the variant of the locale used for collation, eg "@collation=phonebook".
+// Ignored when `rules` is set.
+func (k *DatatypeICUCollationKeyword) Variant(variant string) *DatatypeICUCollationKeyword {
+	k.variant = variant
+	return k
+}
+
+// Rules sets custom tailored collation rules.
+//
+// See http://userguide.icu-project.org/collation/customization
+// for details.
+func (k *DatatypeICUCollationKeyword) Rules(rules string) *DatatypeICUCollationKeyword {
+	k.rules = rules
+	return k
+}
+
+// Strength sets the comparison level.
+// Can be set to the following values:
+// "primary" - Base characters only.
+// "secondary" - Base characters and accents.
+// "tertiary" - Base characters, accents and case.
+// "quaternary" - Same as "tertiary" with punctuation, used with Alternate "shifted".
+// "identical" - All differences are significant.
+// Defaults to "tertiary" for most locales.
+func (k *DatatypeICUCollationKeyword) Strength(strength string) *DatatypeICUCollationKeyword {
+	k.strength = strength
+	return k
+}
+
+// Decomposition sets whether canonical decomposition is performed on the text.
+// Can be set to the following values:
+// "no" - No decomposition.
+// "canonical" - Canonical decomposition.
+// Defaults to "no".
+func (k *DatatypeICUCollationKeyword) Decomposition(decomposition string) *DatatypeICUCollationKeyword {
+	k.decomposition = decomposition
+	return k
+}
+
+// Alternate sets how variable characters such as whitespace and punctuation are handled.
+// Can be set to the following values:
+// "shifted" - Ignore variable characters, unless Strength is "quaternary".
+// "non-ignorable" - Variable characters are significant.
+// Defaults to "non-ignorable".
+func (k *DatatypeICUCollationKeyword) Alternate(alternate string) *DatatypeICUCollationKeyword {
+	k.alternate = alternate
+	return k
+}
+
+// CaseLevel sets whether case should be distinguished, even when Strength is "primary".
+// Defaults to false.
+func (k *DatatypeICUCollationKeyword) CaseLevel(caseLevel bool) *DatatypeICUCollationKeyword {
+	k.caseLevel = &caseLevel
+	return k
+}
+
+// CaseFirst sets which case sorts first when case is distinguished.
+// Can be set to the following values:
+// "lower" - Lowercase sorts before uppercase.
+// "upper" - Uppercase sorts before lowercase.
+func (k *DatatypeICUCollationKeyword) CaseFirst(caseFirst string) *DatatypeICUCollationKeyword {
+	k.caseFirst = caseFirst
+	return k
+}
+
+// Numeric sets whether digits are sorted according to their numeric representation, eg
+// "egg-9" sorts before "egg-21".
+// Defaults to false.
+func (k *DatatypeICUCollationKeyword) Numeric(numeric bool) *DatatypeICUCollationKeyword {
+	k.numeric = &numeric
+	return k
+}
+
+// VariableTop sets the single character or contraction up to which characters are treated as
+// variable. Only effective when Alternate is "shifted".
+func (k *DatatypeICUCollationKeyword) VariableTop(variableTop string) *DatatypeICUCollationKeyword {
+	k.variableTop = variableTop
+	return k
+}
+
+// HiraganaQuaternaryMode sets whether Katakana and Hiragana characters are distinguished at
+// the quaternary level.
+// Defaults to false.
+func (k *DatatypeICUCollationKeyword) HiraganaQuaternaryMode(hiraganaQuaternaryMode bool) *DatatypeICUCollationKeyword {
+	k.hiraganaQuaternaryMode = &hiraganaQuaternaryMode
+	return k
+}
+
+// Validate validates DatatypeICUCollationKeyword.
+func (k *DatatypeICUCollationKeyword) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && k.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if k.strength != "" {
+		if _, valid := map[string]bool{
+			"primary":    true,
+			"secondary":  true,
+			"tertiary":   true,
+			"quaternary": true,
+			"identical":  true,
+		}[k.strength]; !valid {
+			invalid = append(invalid, "Strength")
+		}
+	}
+	if k.decomposition != "" {
+		if _, valid := map[string]bool{
+			"no":        true,
+			"canonical": true,
+		}[k.decomposition]; !valid {
+			invalid = append(invalid, "Decomposition")
+		}
+	}
+	if k.alternate != "" {
+		if _, valid := map[string]bool{
+			"shifted":       true,
+			"non-ignorable": true,
+		}[k.alternate]; !valid {
+			invalid = append(invalid, "Alternate")
+		}
+	}
+	if k.caseFirst != "" {
+		if _, valid := map[string]bool{
+			"lower": true,
+			"upper": true,
+		}[k.caseFirst]; !valid {
+			invalid = append(invalid, "CaseFirst")
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (k *DatatypeICUCollationKeyword) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "icu_collation_keyword",
+	// 		"copy_to": ["field_1", "field_2"],
+	// 		"doc_values": true,
+	// 		"fields": {
+	// 			"field_name": {
+	// 				"type": "keyword"
+	// 			}
+	// 		},
+	// 		"ignore_above": 256,
+	// 		"index": false,
+	// 		"null_value": "NULL",
+	// 		"store": true,
+	// 		"language": "de",
+	// 		"country": "DE",
+	// 		"variant": "@collation=phonebook",
+	// 		"strength": "primary"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "icu_collation_keyword"
+
+	if len(k.copyTo) > 0 {
+		var copyTo interface{}
+		switch {
+		case len(k.copyTo) > 1:
+			copyTo = k.copyTo
+			break
+		case len(k.copyTo) == 1:
+			copyTo = k.copyTo[0]
+			break
+		default:
+			copyTo = ""
+		}
+		options["copy_to"] = copyTo
+	}
+	if k.docValues != nil {
+		options["doc_values"] = k.docValues
+	}
+	if len(k.fields) > 0 {
+		fields := make(map[string]interface{})
+		for _, f := range k.fields {
+			field, err := f.Source(false)
+			if err != nil {
+				return nil, err
+			}
+			fields[f.Name()] = field
+		}
+		options["fields"] = fields
+	}
+	if k.ignoreAbove != nil {
+		options["ignore_above"] = k.ignoreAbove
+	}
+	if k.index != nil {
+		options["index"] = k.index
+	}
+	if k.nullValue != "" {
+		options["null_value"] = k.nullValue
+	}
+	if k.store != nil {
+		options["store"] = k.store
+	}
+	if k.language != "" {
+		options["language"] = k.language
+	}
+	if k.country != "" {
+		options["country"] = k.country
+	}
+	if k.variant != "" {
+		options["variant"] = k.variant
+	}
+	if k.rules != "" {
+		options["rules"] = k.rules
+	}
+	if k.strength != "" {
+		options["strength"] = k.strength
+	}
+	if k.decomposition != "" {
+		options["decomposition"] = k.decomposition
+	}
+	if k.alternate != "" {
+		options["alternate"] = k.alternate
+	}
+	if k.caseLevel != nil {
+		options["case_level"] = k.caseLevel
+	}
+	if k.caseFirst != "" {
+		options["case_first"] = k.caseFirst
+	}
+	if k.numeric != nil {
+		options["numeric"] = k.numeric
+	}
+	if k.variableTop != "" {
+		options["variable_top"] = k.variableTop
+	}
+	if k.hiraganaQuaternaryMode != nil {
+		options["hiragana_quaternary_mode"] = k.hiraganaQuaternaryMode
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[k.name] = options
+	return source, nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDatatypeICUCollationKeywordSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		k           *DatatypeICUCollationKeyword
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name with Index and Language.",
+			k:           NewDatatypeICUCollationKeyword("test").Index(false).Language("de"),
+			includeName: true,
+			expected:    `{"test":{"index":false,"language":"de","type":"icu_collation_keyword"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with Strength and Variant and HiraganaQuaternaryMode.",
+			k:           NewDatatypeICUCollationKeyword("test").Strength("quaternary").Variant("@collation=phonebook").HiraganaQuaternaryMode(true),
+			includeName: false,
+			expected:    `{"hiragana_quaternary_mode":true,"strength":"quaternary","type":"icu_collation_keyword","variant":"@collation=phonebook"}`,
+		},
+		// #2
+		{
+			desc:        "Include Name with Rules and CopyTo.",
+			k:           NewDatatypeICUCollationKeyword("sort").Rules("&a < b").CopyTo("field_1"),
+			includeName: true,
+			expected:    `{"sort":{"copy_to":"field_1","rules":"\u0026a \u003c b","type":"icu_collation_keyword"}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.k.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
@@ -4,7 +4,10 @@
 
 package estemplate
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
 // DatatypeInteger Core Datatype for numeric value.
 // A signed 32-bit integer with a minimum value of -2³¹ and a maximum value of 2³¹-1.
@@ -17,13 +20,15 @@ type DatatypeInteger struct {
 	copyTo []string
 
 	// fields specific to integer datatype
-	coerce          *bool
-	boost           *float32
-	docValues       *bool
-	ignoreMalformed *bool
-	index           *bool
-	nullValue       *int
-	store           *bool
+	coerce              *bool
+	boost               *float32
+	docValues           *bool
+	ignoreMalformed     *bool
+	index               *bool
+	nullValue           *int64
+	store               *bool
+	timeSeriesDimension *bool
+	timeSeriesMetric    string
 }
 
 // NewDatatypeInteger initializes a new DatatypeInteger.
@@ -97,12 +102,12 @@ func (i *DatatypeInteger) Index(index bool) *DatatypeInteger {
 	return i
 }
 
-// NullValue sets a numeric value which is substituted for any explicit null values.
-// Defaults to null.
+// NullValue sets a numeric value which is substituted for any explicit null values,
+// within the integer range [-2^31, 2^31-1]. Defaults to null.
 //
 // See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/null-value.html
 // for details.
-func (i *DatatypeInteger) NullValue(nullValue int) *DatatypeInteger {
+func (i *DatatypeInteger) NullValue(nullValue int64) *DatatypeInteger {
 	i.nullValue = &nullValue
 	return i
 }
@@ -117,14 +122,46 @@ func (i *DatatypeInteger) Store(store bool) *DatatypeInteger {
 	return i
 }
 
+// TimeSeriesDimension sets whether the field is a time series dimension, identifying together
+// with the other dimensions the time series of the documents of `time_series` indices.
+// Defaults to false.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-dimension
+// for details.
+func (i *DatatypeInteger) TimeSeriesDimension(timeSeriesDimension bool) *DatatypeInteger {
+	i.timeSeriesDimension = &timeSeriesDimension
+	return i
+}
+
+// TimeSeriesMetric sets the time series metric type of the field, which can be set to "gauge"
+// or "counter". Metric fields cannot be time series dimensions.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-metric
+// for details.
+func (i *DatatypeInteger) TimeSeriesMetric(timeSeriesMetric string) *DatatypeInteger {
+	i.timeSeriesMetric = timeSeriesMetric
+	return i
+}
+
 // Validate validates DatatypeInteger.
 func (i *DatatypeInteger) Validate(includeName bool) error {
 	var invalid []string
 	if includeName && i.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if i.nullValue != nil && (*i.nullValue < math.MinInt32 || *i.nullValue > math.MaxInt32) {
+		invalid = append(invalid, "NullValue")
+	}
+	if i.timeSeriesMetric != "" {
+		if valid := validTimeSeriesMetrics[i.timeSeriesMetric]; !valid {
+			invalid = append(invalid, "TimeSeriesMetric")
+		}
+	}
+	if i.timeSeriesMetric != "" && i.timeSeriesDimension != nil && *i.timeSeriesDimension {
+		invalid = append(invalid, "TimeSeriesMetric")
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
@@ -141,7 +178,9 @@ func (i *DatatypeInteger) Source(includeName bool) (interface{}, error) {
 	// 		"ignore_malformed": true,
 	// 		"index": true,
 	// 		"null_value": 0,
-	// 		"store": true
+	// 		"store": true,
+	// 		"time_series_dimension": true,
+	// 		"time_series_metric": "gauge"
 	// 	}
 	// }
 	options := make(map[string]interface{})
@@ -182,6 +221,12 @@ func (i *DatatypeInteger) Source(includeName bool) (interface{}, error) {
 	if i.store != nil {
 		options["store"] = i.store
 	}
+	if i.timeSeriesDimension != nil {
+		options["time_series_dimension"] = i.timeSeriesDimension
+	}
+	if i.timeSeriesMetric != "" {
+		options["time_series_metric"] = i.timeSeriesMetric
+	}
 
 	if !includeName {
 		return options, nil
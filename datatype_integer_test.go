@@ -6,6 +6,7 @@ package estemplate
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 )
 
@@ -30,6 +31,13 @@ func TestDatatypeIntegerSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"coerce":true,"index":true,"type":"integer"}`,
 		},
+		// #2
+		{
+			desc:        "Exclude Name with NullValue.",
+			i:           NewDatatypeInteger("test").NullValue(-2147483648),
+			includeName: false,
+			expected:    `{"null_value":-2147483648,"type":"integer"}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -46,4 +54,9 @@ func TestDatatypeIntegerSerialization(t *testing.T) {
 			}
 		})
 	}
+	for _, nullValue := range []int64{math.MinInt32 - 1, math.MaxInt32 + 1} {
+		if err := NewDatatypeInteger("test").NullValue(nullValue).Validate(true); err == nil {
+			t.Errorf("expected DatatypeInteger validation error for null value %d, got nil", nullValue)
+		}
+	}
 }
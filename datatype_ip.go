@@ -16,11 +16,12 @@ type DatatypeIP struct {
 	copyTo []string
 
 	// fields specific to ip datatype
-	boost     *float32
-	docValues *bool
-	index     *bool
-	nullValue string
-	store     *bool
+	boost               *float32
+	docValues           *bool
+	index               *bool
+	nullValue           string
+	store               *bool
+	timeSeriesDimension *bool
 }
 
 // NewDatatypeIP initializes a new DatatypeIP.
@@ -94,6 +95,17 @@ func (ip *DatatypeIP) Store(store bool) *DatatypeIP {
 	return ip
 }
 
+// TimeSeriesDimension sets whether the field is a time series dimension, identifying together
+// with the other dimensions the time series of the documents of `time_series` indices.
+// Defaults to false.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-dimension
+// for details.
+func (ip *DatatypeIP) TimeSeriesDimension(timeSeriesDimension bool) *DatatypeIP {
+	ip.timeSeriesDimension = &timeSeriesDimension
+	return ip
+}
+
 // Validate validates DatatypeIP.
 func (ip *DatatypeIP) Validate(includeName bool) error {
 	var invalid []string
@@ -116,7 +128,8 @@ func (ip *DatatypeIP) Source(includeName bool) (interface{}, error) {
 	// 		"doc_values": true,
 	// 		"index": true,
 	// 		"null_value": "192.168.0.0/16",
-	// 		"store" true
+	// 		"store" true,
+	// 		"time_series_dimension": true
 	// 	}
 	// }
 	options := make(map[string]interface{})
@@ -151,6 +164,9 @@ func (ip *DatatypeIP) Source(includeName bool) (interface{}, error) {
 	if ip.store != nil {
 		options["store"] = ip.store
 	}
+	if ip.timeSeriesDimension != nil {
+		options["time_series_dimension"] = ip.timeSeriesDimension
+	}
 
 	if !includeName {
 		return options, nil
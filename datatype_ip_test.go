@@ -30,6 +30,13 @@ func TestDatatypeIPSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"doc_values":true,"index":true,"type":"ip"}`,
 		},
+		// #2
+		{
+			desc:        "Exclude Name with TimeSeriesDimension.",
+			ip:          NewDatatypeIP("test").TimeSeriesDimension(true),
+			includeName: false,
+			expected:    `{"time_series_dimension":true,"type":"ip"}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
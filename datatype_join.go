@@ -4,7 +4,10 @@
 
 package estemplate
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // DatatypeJoin Specialised Datatype that creates parent/child relation
 // within documents of the same index.
@@ -61,12 +64,117 @@ func (j *DatatypeJoin) EagerGlobalOrdinals(eagerGlobalOrdinals bool) *DatatypeJo
 	return j
 }
 
+// relation returns the relation declaring parent, and whether it exists.
+func (j *DatatypeJoin) relation(parent string) (*Relation, bool) {
+	for _, r := range j.relations {
+		if r.parent == parent {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// parents returns the parent of every child of the relations.
+func (j *DatatypeJoin) parents() map[string]string {
+	parents := make(map[string]string)
+	for _, r := range j.relations {
+		for _, child := range r.children {
+			if _, exists := parents[child]; !exists {
+				parents[child] = r.parent
+			}
+		}
+	}
+	return parents
+}
+
+// lineage returns the relation names from the root parent down to name, or nil when the
+// relations of name form a cycle.
+func (j *DatatypeJoin) lineage(name string) []string {
+	parents := j.parents()
+	lineage := []string{name}
+	visited := map[string]bool{name: true}
+	for parent, ok := parents[name]; ok; parent, ok = parents[parent] {
+		if visited[parent] {
+			return nil
+		}
+		visited[parent] = true
+		lineage = append([]string{parent}, lineage...)
+	}
+	return lineage
+}
+
+// ValidateRelations validates the parent/child relations of the join datatype: a parent is
+// only declared once, a relation cannot be a child of itself, a child only has one parent, and
+// relations do not form cycles.
+func (j *DatatypeJoin) ValidateRelations() error {
+	var invalid []string
+	declared := make(map[string]bool)
+	parents := make(map[string]string)
+	for _, r := range j.relations {
+		if r.parent == "" {
+			invalid = append(invalid, "relation parent is required")
+			continue
+		}
+		if declared[r.parent] {
+			invalid = append(invalid, fmt.Sprintf("parent [%s] is declared more than once", r.parent))
+		}
+		declared[r.parent] = true
+		if len(r.children) == 0 {
+			invalid = append(invalid, fmt.Sprintf("parent [%s] has no children", r.parent))
+		}
+		for _, child := range r.children {
+			switch parent, exists := parents[child]; {
+			case child == r.parent:
+				invalid = append(invalid, fmt.Sprintf("parent [%s] cannot be a child of itself", r.parent))
+			case exists:
+				invalid = append(invalid, fmt.Sprintf("child [%s] has multiple parents [%s] and [%s]", child, parent, r.parent))
+			default:
+				parents[child] = r.parent
+			}
+		}
+	}
+	for _, r := range j.relations {
+		if _, isChild := parents[r.parent]; isChild && r.parent != "" && j.lineage(r.parent) == nil {
+			invalid = append(invalid, fmt.Sprintf("parent [%s] is part of a relation cycle", r.parent))
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid relations: %v", invalid)
+	}
+	return nil
+}
+
+// RelationWarnings returns warnings for the relations with multiple levels of children, ie
+// grandchildren, as each level of relation adds an overhead to queries in memory and
+// computation. Prefer denormalizing data when performance matters.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/parent-join.html#_parent_join_and_performance
+// for details.
+func (j *DatatypeJoin) RelationWarnings() []string {
+	var warnings []string
+	for _, r := range j.relations {
+		for _, child := range r.children {
+			if _, isParent := j.relation(child); isParent {
+				continue
+			}
+			if lineage := j.lineage(child); len(lineage) > 2 {
+				warnings = append(warnings, fmt.Sprintf("relation [%s] of join field [%s] has %d levels, "+
+					"multiple levels of relations add overhead to queries", strings.Join(lineage, " > "), j.name, len(lineage)))
+			}
+		}
+	}
+	return warnings
+}
+
 // Validate validates DatatypeJoin.
 func (j *DatatypeJoin) Validate(includeName bool) error {
 	var invalid []string
 	if includeName && j.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if err := j.ValidateRelations(); err != nil {
+		invalid = append(invalid, "Relations")
+	}
 	if len(invalid) > 0 {
 		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
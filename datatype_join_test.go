@@ -6,6 +6,7 @@ package estemplate
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 )
 
@@ -54,3 +55,77 @@ func TestDatatypeJoinSerialization(t *testing.T) {
 		})
 	}
 }
+
+func TestDatatypeJoinValidateRelations(t *testing.T) {
+	tests := []struct {
+		desc     string
+		j        *DatatypeJoin
+		expected []string
+	}{
+		// #0
+		{
+			desc: "Multiple levels of relations.",
+			j:    NewDatatypeJoin("test").Relations(NewRelation("question", "answer", "comment"), NewRelation("answer", "vote")),
+		},
+		// #1
+		{
+			desc:     "Parent as a child of itself.",
+			j:        NewDatatypeJoin("test").Relations(NewRelation("question", "question")),
+			expected: []string{"parent [question] cannot be a child of itself"},
+		},
+		// #2
+		{
+			desc:     "Relation cycle.",
+			j:        NewDatatypeJoin("test").Relations(NewRelation("question", "answer"), NewRelation("answer", "question")),
+			expected: []string{"parent [question] is part of a relation cycle", "parent [answer] is part of a relation cycle"},
+		},
+		// #3
+		{
+			desc:     "Child with multiple parents.",
+			j:        NewDatatypeJoin("test").Relations(NewRelation("question", "comment"), NewRelation("answer", "comment")),
+			expected: []string{"child [comment] has multiple parents [question] and [answer]"},
+		},
+		// #4
+		{
+			desc:     "Parent declared more than once.",
+			j:        NewDatatypeJoin("test").Relations(NewRelation("question", "answer"), NewRelation("question", "comment")),
+			expected: []string{"parent [question] is declared more than once"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.j.ValidateRelations()
+			if len(test.expected) == 0 {
+				if err != nil {
+					t.Errorf("expected valid, got: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			for _, expected := range test.expected {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error to contain %q, got: %v", expected, err)
+				}
+			}
+		})
+	}
+	if err := NewDatatypeJoin("test").Relations(NewRelation("question", "question")).Validate(true); err == nil {
+		t.Error("expected DatatypeJoin validation error, got nil")
+	}
+}
+
+func TestDatatypeJoinRelationWarnings(t *testing.T) {
+	j := NewDatatypeJoin("my_join_field").Relations(NewRelation("question", "answer", "comment"), NewRelation("answer", "vote"))
+	warnings := j.RelationWarnings()
+	if len(warnings) != 1 {
+		t.Fatalf("expected 1 warning, got: %v", warnings)
+	}
+	if expected := "relation [question > answer > vote] of join field [my_join_field] has 3 levels"; !strings.Contains(warnings[0], expected) {
+		t.Errorf("expected warning to contain %q, got: %v", expected, warnings[0])
+	}
+	if warnings := NewDatatypeJoin("my_join_field").Relations(NewRelation("question", "answer")).RelationWarnings(); len(warnings) != 0 {
+		t.Errorf("expected no warnings, got: %v", warnings)
+	}
+}
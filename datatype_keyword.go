@@ -30,6 +30,7 @@ type DatatypeKeyword struct {
 	similarity               string
 	normalizer               string
 	splitQueriesOnWhitespace *bool
+	timeSeriesDimension      *bool
 }
 
 // NewDatatypeKeyword initializes a new DatatypeKeyword.
@@ -190,6 +191,17 @@ func (k *DatatypeKeyword) SplitQueriesOnWhitespace(splitQueriesOnWhitespace bool
 	return k
 }
 
+// TimeSeriesDimension sets whether the field is a time series dimension, identifying together
+// with the other dimensions the time series of the documents of `time_series` indices.
+// Defaults to false.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-dimension
+// for details.
+func (k *DatatypeKeyword) TimeSeriesDimension(timeSeriesDimension bool) *DatatypeKeyword {
+	k.timeSeriesDimension = &timeSeriesDimension
+	return k
+}
+
 // Validate validates DatatypeKeyword.
 func (k *DatatypeKeyword) Validate(includeName bool) error {
 	var invalid []string
@@ -241,7 +253,8 @@ func (k *DatatypeKeyword) Source(includeName bool) (interface{}, error) {
 	// 		"store": true,
 	// 		"similarity": "BM25",
 	// 		"normalizer": "my_normalizer",
-	// 		"split_queries_on_whitespace": true
+	// 		"split_queries_on_whitespace": true,
+	// 		"time_series_dimension": true
 	// 	}
 	// }
 	options := make(map[string]interface{})
@@ -308,6 +321,9 @@ func (k *DatatypeKeyword) Source(includeName bool) (interface{}, error) {
 	if k.splitQueriesOnWhitespace != nil {
 		options["split_queries_on_whitespace"] = k.splitQueriesOnWhitespace
 	}
+	if k.timeSeriesDimension != nil {
+		options["time_series_dimension"] = k.timeSeriesDimension
+	}
 
 	if !includeName {
 		return options, nil
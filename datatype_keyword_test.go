@@ -37,6 +37,13 @@ func TestDatatypeKeywordSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"index":true,"normalizer":"my_normalizer","type":"keyword"}`,
 		},
+		// #3
+		{
+			desc:        "Exclude Name with TimeSeriesDimension.",
+			k:           NewDatatypeKeyword("test").TimeSeriesDimension(true),
+			includeName: false,
+			expected:    `{"time_series_dimension":true,"type":"keyword"}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
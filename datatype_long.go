@@ -17,13 +17,15 @@ type DatatypeLong struct {
 	copyTo []string
 
 	// fields specific to long datatype
-	coerce          *bool
-	boost           *float32
-	docValues       *bool
-	ignoreMalformed *bool
-	index           *bool
-	nullValue       *int
-	store           *bool
+	coerce              *bool
+	boost               *float32
+	docValues           *bool
+	ignoreMalformed     *bool
+	index               *bool
+	nullValue           *int64
+	store               *bool
+	timeSeriesDimension *bool
+	timeSeriesMetric    string
 }
 
 // NewDatatypeLong initializes a new DatatypeLong.
@@ -102,7 +104,7 @@ func (l *DatatypeLong) Index(index bool) *DatatypeLong {
 //
 // See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/null-value.html
 // for details.
-func (l *DatatypeLong) NullValue(nullValue int) *DatatypeLong {
+func (l *DatatypeLong) NullValue(nullValue int64) *DatatypeLong {
 	l.nullValue = &nullValue
 	return l
 }
@@ -117,14 +119,43 @@ func (l *DatatypeLong) Store(store bool) *DatatypeLong {
 	return l
 }
 
+// TimeSeriesDimension sets whether the field is a time series dimension, identifying together
+// with the other dimensions the time series of the documents of `time_series` indices.
+// Defaults to false.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-dimension
+// for details.
+func (l *DatatypeLong) TimeSeriesDimension(timeSeriesDimension bool) *DatatypeLong {
+	l.timeSeriesDimension = &timeSeriesDimension
+	return l
+}
+
+// TimeSeriesMetric sets the time series metric type of the field, which can be set to "gauge"
+// or "counter". Metric fields cannot be time series dimensions.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-metric
+// for details.
+func (l *DatatypeLong) TimeSeriesMetric(timeSeriesMetric string) *DatatypeLong {
+	l.timeSeriesMetric = timeSeriesMetric
+	return l
+}
+
 // Validate validates DatatypeLong.
 func (l *DatatypeLong) Validate(includeName bool) error {
 	var invalid []string
 	if includeName && l.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if l.timeSeriesMetric != "" {
+		if valid := validTimeSeriesMetrics[l.timeSeriesMetric]; !valid {
+			invalid = append(invalid, "TimeSeriesMetric")
+		}
+	}
+	if l.timeSeriesMetric != "" && l.timeSeriesDimension != nil && *l.timeSeriesDimension {
+		invalid = append(invalid, "TimeSeriesMetric")
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
@@ -141,7 +172,9 @@ func (l *DatatypeLong) Source(includeName bool) (interface{}, error) {
 	// 		"ignore_malformed": true,
 	// 		"index": true,
 	// 		"null_value": 0,
-	// 		"store": true
+	// 		"store": true,
+	// 		"time_series_dimension": true,
+	// 		"time_series_metric": "gauge"
 	// 	}
 	// }
 	options := make(map[string]interface{})
@@ -182,6 +215,12 @@ func (l *DatatypeLong) Source(includeName bool) (interface{}, error) {
 	if l.store != nil {
 		options["store"] = l.store
 	}
+	if l.timeSeriesDimension != nil {
+		options["time_series_dimension"] = l.timeSeriesDimension
+	}
+	if l.timeSeriesMetric != "" {
+		options["time_series_metric"] = l.timeSeriesMetric
+	}
 
 	if !includeName {
 		return options, nil
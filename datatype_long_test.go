@@ -6,6 +6,7 @@ package estemplate
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 )
 
@@ -30,6 +31,20 @@ func TestDatatypeLongSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"coerce":true,"index":true,"type":"long"}`,
 		},
+		// #2
+		{
+			desc:        "Exclude Name with NullValue.",
+			l:           NewDatatypeLong("test").NullValue(math.MaxInt64),
+			includeName: false,
+			expected:    `{"null_value":9223372036854775807,"type":"long"}`,
+		},
+		// #3
+		{
+			desc:        "Exclude Name with TimeSeriesMetric.",
+			l:           NewDatatypeLong("test").TimeSeriesMetric("counter"),
+			includeName: false,
+			expected:    `{"time_series_metric":"counter","type":"long"}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -46,4 +61,10 @@ func TestDatatypeLongSerialization(t *testing.T) {
 			}
 		})
 	}
+	if err := NewDatatypeLong("test").TimeSeriesMetric("summary").Validate(true); err == nil {
+		t.Error("expected DatatypeLong validation error, got nil")
+	}
+	if err := NewDatatypeLong("test").TimeSeriesDimension(true).TimeSeriesMetric("gauge").Validate(true); err == nil {
+		t.Error("expected DatatypeLong validation error, got nil")
+	}
 }
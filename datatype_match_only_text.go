@@ -0,0 +1,123 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// DatatypeMatchOnlyText Core Datatype for full text content, a variant of `text`
+// that trades scoring and efficiency of positional queries for space efficiency, by
+// not indexing frequencies, positions and norms. Requires Elasticsearch 7.14 or later.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.14/text.html#match-only-text-field-type
+// for details.
+type DatatypeMatchOnlyText struct {
+	Datatype
+	name   string
+	copyTo []string
+
+	// fields specific to match only text datatype
+	fields []Datatype
+}
+
+// NewDatatypeMatchOnlyText initializes a new DatatypeMatchOnlyText.
+func NewDatatypeMatchOnlyText(name string) *DatatypeMatchOnlyText {
+	return &DatatypeMatchOnlyText{
+		name:   name,
+		fields: make([]Datatype, 0),
+	}
+}
+
+// Name returns field key for the Datatype.
+func (t *DatatypeMatchOnlyText) Name() string {
+	return t.name
+}
+
+// MinimumVersion returns the minimum Elasticsearch version supporting the Datatype.
+func (t *DatatypeMatchOnlyText) MinimumVersion() string {
+	return "7.14.0"
+}
+
+// CopyTo sets the field(s) to copy to which allows the values of multiple fields to be
+// queried as a single field.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.14/copy-to.html
+// for details.
+func (t *DatatypeMatchOnlyText) CopyTo(copyTo ...string) *DatatypeMatchOnlyText {
+	t.copyTo = append(t.copyTo, copyTo...)
+	return t
+}
+
+// Fields sets multi-fields which allow the same string value to be indexed in multiple
+// ways for different purposes.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.14/multi-fields.html
+// for details.
+func (t *DatatypeMatchOnlyText) Fields(fields ...Datatype) *DatatypeMatchOnlyText {
+	t.fields = append(t.fields, fields...)
+	return t
+}
+
+// Validate validates DatatypeMatchOnlyText.
+func (t *DatatypeMatchOnlyText) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && t.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (t *DatatypeMatchOnlyText) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "match_only_text",
+	// 		"copy_to": ["field_1", "field_2"],
+	// 		"fields": {
+	// 			"field_name": {
+	// 				"type": "keyword"
+	// 			}
+	// 		}
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "match_only_text"
+
+	if len(t.copyTo) > 0 {
+		var copyTo interface{}
+		switch {
+		case len(t.copyTo) > 1:
+			copyTo = t.copyTo
+			break
+		case len(t.copyTo) == 1:
+			copyTo = t.copyTo[0]
+			break
+		default:
+			copyTo = ""
+		}
+		options["copy_to"] = copyTo
+	}
+	if len(t.fields) > 0 {
+		fields := make(map[string]interface{})
+		for _, f := range t.fields {
+			field, err := f.Source(false)
+			if err != nil {
+				return nil, err
+			}
+			fields[f.Name()] = field
+		}
+		options["fields"] = fields
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[t.name] = options
+	return source, nil
+}
@@ -17,9 +17,13 @@ type DatatypeNested struct {
 	copyTo []string
 
 	// fields specific to nested datatype
-	dynamic    *bool
-	strict     bool
-	properties []Datatype
+	dynamic         *bool
+	strict          bool
+	dynamicMode     DynamicMode
+	enabled         *bool
+	includeInParent *bool
+	includeInRoot   *bool
+	properties      []Datatype
 }
 
 // NewDatatypeNested initializes a new DatatypeNested.
@@ -65,6 +69,46 @@ func (n *DatatypeNested) Strict(strict bool) *DatatypeNested {
 	return n
 }
 
+// DynamicMode sets how new fields detected in the nested object are handled, which can be set to
+// true, false, "strict" or "runtime". Takes precedence over Dynamic and Strict.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.11/dynamic.html
+// for details.
+func (n *DatatypeNested) DynamicMode(dynamicMode DynamicMode) *DatatypeNested {
+	n.dynamicMode = dynamicMode
+	return n
+}
+
+// Enabled sets whether if the JSON value given for the nested field should be parsed
+// and indexed, or completely ignored. Defaults to true.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/enabled.html
+// for details.
+func (n *DatatypeNested) Enabled(enabled bool) *DatatypeNested {
+	n.enabled = &enabled
+	return n
+}
+
+// IncludeInParent sets whether all fields in the nested object are also added to the parent
+// document as standard (flat) fields. Defaults to false.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/nested.html#nested-params
+// for details.
+func (n *DatatypeNested) IncludeInParent(includeInParent bool) *DatatypeNested {
+	n.includeInParent = &includeInParent
+	return n
+}
+
+// IncludeInRoot sets whether all fields in the nested object are also added to the root
+// document as standard (flat) fields. Defaults to false.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/nested.html#nested-params
+// for details.
+func (n *DatatypeNested) IncludeInRoot(includeInRoot bool) *DatatypeNested {
+	n.includeInRoot = &includeInRoot
+	return n
+}
+
 // Properties sets the fields within the object, which can be of any datatype, including
 // object. New properties may be added to an existing object.
 //
@@ -81,8 +125,20 @@ func (n *DatatypeNested) Validate(includeName bool) error {
 	if includeName && n.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if n.dynamicMode != "" && !validDynamicModes[n.dynamicMode] {
+		invalid = append(invalid, "DynamicMode")
+	}
+	// fields of a nested object which is not parsed cannot be copied to its parent or root.
+	if n.enabled != nil && !*n.enabled {
+		if n.includeInParent != nil && *n.includeInParent {
+			invalid = append(invalid, "IncludeInParent")
+		}
+		if n.includeInRoot != nil && *n.includeInRoot {
+			invalid = append(invalid, "IncludeInRoot")
+		}
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
@@ -94,6 +150,9 @@ func (n *DatatypeNested) Source(includeName bool) (interface{}, error) {
 	// 		"type": "nested",
 	// 		"copy_to": ["field_1", "field_2"],
 	// 		"dynamic": true,
+	// 		"enabled": true,
+	// 		"include_in_parent": false,
+	// 		"include_in_root": false,
 	// 		"properties": {
 	// 			"field_name": {
 	// 				"type": "text",
@@ -119,11 +178,17 @@ func (n *DatatypeNested) Source(includeName bool) (interface{}, error) {
 		}
 		options["copy_to"] = copyTo
 	}
-	if n.dynamic != nil {
-		options["dynamic"] = n.dynamic
+	if dynamic := resolveDynamicMode(n.dynamic, n.strict, n.dynamicMode); dynamic != "" {
+		options["dynamic"] = dynamic.value()
+	}
+	if n.enabled != nil {
+		options["enabled"] = n.enabled
+	}
+	if n.includeInParent != nil {
+		options["include_in_parent"] = n.includeInParent
 	}
-	if n.strict {
-		options["dynamic"] = "strict"
+	if n.includeInRoot != nil {
+		options["include_in_root"] = n.includeInRoot
 	}
 	if len(n.properties) > 0 {
 		properties := make(map[string]interface{})
@@ -37,6 +37,13 @@ func TestDatatypeNestedSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"dynamic":"strict","type":"nested"}`,
 		},
+		// #3
+		{
+			desc:        "Exclude Name with DynamicMode, IncludeInParent and IncludeInRoot.",
+			n:           NewDatatypeNested("test").DynamicMode(DynamicModeFalse).IncludeInParent(true).IncludeInRoot(false),
+			includeName: false,
+			expected:    `{"dynamic":false,"include_in_parent":true,"include_in_root":false,"type":"nested"}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -53,4 +60,7 @@ func TestDatatypeNestedSerialization(t *testing.T) {
 			}
 		})
 	}
+	if err := NewDatatypeNested("test").Enabled(false).IncludeInParent(true).Validate(true); err == nil {
+		t.Error("expected DatatypeNested validation error, got nil")
+	}
 }
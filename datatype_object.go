@@ -18,10 +18,12 @@ type DatatypeObject struct {
 	copyTo []string
 
 	// fields specific to object datatype
-	dynamic    *bool
-	strict     bool
-	enabled    *bool
-	properties []Datatype
+	dynamic     *bool
+	strict      bool
+	dynamicMode DynamicMode
+	enabled     *bool
+	subobjects  *bool
+	properties  []Datatype
 }
 
 // NewDatatypeObject initializes a new DatatypeObject.
@@ -67,6 +69,16 @@ func (o *DatatypeObject) Strict(strict bool) *DatatypeObject {
 	return o
 }
 
+// DynamicMode sets how new fields detected in the object are handled, which can be set to
+// true, false, "strict" or "runtime". Takes precedence over Dynamic and Strict.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.11/dynamic.html
+// for details.
+func (o *DatatypeObject) DynamicMode(dynamicMode DynamicMode) *DatatypeObject {
+	o.dynamicMode = dynamicMode
+	return o
+}
+
 // Enabled sets whether if the JSON value given for the object field should be parsed
 // and indexed, or completely ignored. Defaults to true.
 //
@@ -77,6 +89,16 @@ func (o *DatatypeObject) Enabled(enabled bool) *DatatypeObject {
 	return o
 }
 
+// Subobjects sets whether the object can hold further objects, when disabled, field names
+// containing dots are stored as leaf fields, eg "metrics.time.max". Defaults to true.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.3/subobjects.html
+// for details.
+func (o *DatatypeObject) Subobjects(subobjects bool) *DatatypeObject {
+	o.subobjects = &subobjects
+	return o
+}
+
 // Properties sets the fields within the object, which can be of any datatype, including
 // object. New properties may be added to an existing object.
 //
@@ -93,8 +115,15 @@ func (o *DatatypeObject) Validate(includeName bool) error {
 	if includeName && o.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if o.dynamicMode != "" && !validDynamicModes[o.dynamicMode] {
+		invalid = append(invalid, "DynamicMode")
+	}
+	// objects with subobjects disabled cannot hold object or nested properties.
+	if o.subobjects != nil && !*o.subobjects && hasSubobjects(o.properties) {
+		invalid = append(invalid, "Subobjects")
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
@@ -107,6 +136,7 @@ func (o *DatatypeObject) Source(includeName bool) (interface{}, error) {
 	// 		"copy_to": ["field_1", "field_2"],
 	// 		"dynamic": true,
 	// 		"enabled": true,
+	// 		"subobjects": true,
 	// 		"properties": {
 	// 			"field_name": {
 	// 				"type": "text",
@@ -132,15 +162,15 @@ func (o *DatatypeObject) Source(includeName bool) (interface{}, error) {
 		}
 		options["copy_to"] = copyTo
 	}
-	if o.dynamic != nil {
-		options["dynamic"] = o.dynamic
-	}
-	if o.strict {
-		options["dynamic"] = "strict"
+	if dynamic := resolveDynamicMode(o.dynamic, o.strict, o.dynamicMode); dynamic != "" {
+		options["dynamic"] = dynamic.value()
 	}
 	if o.enabled != nil {
 		options["enabled"] = o.enabled
 	}
+	if o.subobjects != nil {
+		options["subobjects"] = o.subobjects
+	}
 	if len(o.properties) > 0 {
 		properties := make(map[string]interface{})
 		for _, f := range o.properties {
@@ -37,6 +37,13 @@ func TestDatatypeObjectSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"dynamic":"strict","type":"object"}`,
 		},
+		// #3
+		{
+			desc:        "Exclude Name with DynamicMode and Subobjects.",
+			o:           NewDatatypeObject("test").Strict(true).DynamicMode(DynamicModeRuntime).Subobjects(false),
+			includeName: false,
+			expected:    `{"dynamic":"runtime","subobjects":false,"type":"object"}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -53,4 +60,10 @@ func TestDatatypeObjectSerialization(t *testing.T) {
 			}
 		})
 	}
+	if err := NewDatatypeObject("test").Subobjects(false).Properties(NewDatatypeObject("inner")).Validate(true); err == nil {
+		t.Error("expected DatatypeObject validation error, got nil")
+	}
+	if err := NewDatatypeObject("test").DynamicMode("runtme").Validate(true); err == nil {
+		t.Error("expected DatatypeObject validation error, got nil")
+	}
 }
@@ -0,0 +1,120 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// distancePattern pattern of Elasticsearch distance values, eg "5km".
+var distancePattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?(mi|miles|yd|yards|ft|feet|in|inch|km|kilometers|m|meters|cm|centimeters|mm|millimeters|NM|nmi|nauticalmiles)?$`)
+
+// CompletionContext Datatype parameter that defines a context of a completion datatype, used
+// to filter or boost suggestions by category or by geo location.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/suggester-context.html
+// for details.
+type CompletionContext struct {
+	name      string
+	typ       string
+	path      string
+	precision interface{}
+}
+
+// NewCompletionContext initializes a new CompletionContext, typ can be set to "category" or
+// "geo".
+func NewCompletionContext(name, typ string) *CompletionContext {
+	return &CompletionContext{
+		name: name,
+		typ:  typ,
+	}
+}
+
+// NewCompletionContextCategory initializes a new CompletionContext of type "category", which
+// associates one or more categories with suggestions.
+func NewCompletionContextCategory(name string) *CompletionContext {
+	return NewCompletionContext(name, "category")
+}
+
+// NewCompletionContextGeo initializes a new CompletionContext of type "geo", which associates
+// one or more geo points with suggestions.
+func NewCompletionContextGeo(name string) *CompletionContext {
+	return NewCompletionContext(name, "geo")
+}
+
+// Name returns field key for the CompletionContext.
+func (c *CompletionContext) Name() string {
+	return c.name
+}
+
+// Path sets the field of the document to read the context values from, instead of the
+// `contexts` of the suggestions.
+func (c *CompletionContext) Path(path string) *CompletionContext {
+	c.path = path
+	return c
+}
+
+// Precision sets the geohash precision of geo contexts, from 1 to 12. Defaults to 6.
+func (c *CompletionContext) Precision(precision int) *CompletionContext {
+	c.precision = precision
+	return c
+}
+
+// PrecisionDistance sets the precision of geo contexts as a distance, eg "5km", which is
+// converted to the matching geohash precision.
+func (c *CompletionContext) PrecisionDistance(precisionDistance string) *CompletionContext {
+	c.precision = precisionDistance
+	return c
+}
+
+// Validate validates CompletionContext.
+func (c *CompletionContext) Validate() error {
+	var invalid []string
+	if c.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if valid := map[string]bool{
+		"category": true,
+		"geo":      true,
+	}[c.typ]; !valid {
+		invalid = append(invalid, "Type")
+	}
+	switch precision := c.precision.(type) {
+	case int:
+		if c.typ != "geo" || precision < 1 || precision > 12 {
+			invalid = append(invalid, "Precision")
+		}
+	case string:
+		if c.typ != "geo" || !distancePattern.MatchString(precision) {
+			invalid = append(invalid, "Precision")
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (c *CompletionContext) Source() (interface{}, error) {
+	// {
+	// 	"name": "location",
+	// 	"type": "geo",
+	// 	"path": "loc",
+	// 	"precision": 4
+	// }
+	options := make(map[string]interface{})
+	options["name"] = c.name
+	options["type"] = c.typ
+
+	if c.path != "" {
+		options["path"] = c.path
+	}
+	if c.precision != nil {
+		options["precision"] = c.precision
+	}
+	return options, nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCompletionContextSerialization(t *testing.T) {
+	tests := []struct {
+		desc     string
+		c        *CompletionContext
+		expected string
+	}{
+		// #0
+		{
+			desc:     "Category with Path.",
+			c:        NewCompletionContextCategory("place_type").Path("cat"),
+			expected: `{"name":"place_type","path":"cat","type":"category"}`,
+		},
+		// #1
+		{
+			desc:     "Geo with Precision.",
+			c:        NewCompletionContextGeo("location").Path("loc").Precision(4),
+			expected: `{"name":"location","path":"loc","precision":4,"type":"geo"}`,
+		},
+		// #2
+		{
+			desc:     "Geo with PrecisionDistance.",
+			c:        NewCompletionContext("location", "geo").PrecisionDistance("5km"),
+			expected: `{"name":"location","precision":"5km","type":"geo"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.c.Source()
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestCompletionContextValidation(t *testing.T) {
+	tests := []struct {
+		desc  string
+		c     *CompletionContext
+		valid bool
+	}{
+		// #0
+		{
+			desc:  "Geo with PrecisionDistance.",
+			c:     NewCompletionContextGeo("location").PrecisionDistance("1.5km"),
+			valid: true,
+		},
+		// #1
+		{
+			desc:  "Invalid type.",
+			c:     NewCompletionContext("location", "geo_shape"),
+			valid: false,
+		},
+		// #2
+		{
+			desc:  "Precision on category.",
+			c:     NewCompletionContextCategory("place_type").Precision(4),
+			valid: false,
+		},
+		// #3
+		{
+			desc:  "Precision out of range.",
+			c:     NewCompletionContextGeo("location").Precision(13),
+			valid: false,
+		},
+		// #4
+		{
+			desc:  "Invalid PrecisionDistance.",
+			c:     NewCompletionContextGeo("location").PrecisionDistance("5 lightyears"),
+			valid: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.c.Validate()
+			if test.valid && err != nil {
+				t.Errorf("expected no error, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// DenseVectorIndexOptions Datatype parameter that configures the kNN indexing algorithm
+// of indexed dense vectors.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.12/dense-vector.html#dense-vector-index-options
+// for details.
+type DenseVectorIndexOptions struct {
+	typ            string
+	m              *int
+	efConstruction *int
+}
+
+// NewDenseVectorIndexOptions initializes a new DenseVectorIndexOptions, typ can be set to
+// "hnsw" or "int8_hnsw", which quantizes float vectors to bytes to reduce memory usage.
+func NewDenseVectorIndexOptions(typ string) *DenseVectorIndexOptions {
+	return &DenseVectorIndexOptions{
+		typ: typ,
+	}
+}
+
+// M sets the number of neighbors each node will be connected to in the HNSW graph, between
+// 1 and 512. Defaults to 16.
+func (o *DenseVectorIndexOptions) M(m int) *DenseVectorIndexOptions {
+	o.m = &m
+	return o
+}
+
+// EfConstruction sets the number of candidates to track while assembling the list of nearest
+// neighbors for each new node, between 1 and 3200. Defaults to 100.
+func (o *DenseVectorIndexOptions) EfConstruction(efConstruction int) *DenseVectorIndexOptions {
+	o.efConstruction = &efConstruction
+	return o
+}
+
+// Validate validates DenseVectorIndexOptions.
+func (o *DenseVectorIndexOptions) Validate() error {
+	var invalid []string
+	if valid := map[string]bool{"hnsw": true, "int8_hnsw": true}[o.typ]; !valid {
+		invalid = append(invalid, "Type")
+	}
+	if o.m != nil && (*o.m < 1 || *o.m > 512) {
+		invalid = append(invalid, "M")
+	}
+	if o.efConstruction != nil && (*o.efConstruction < 1 || *o.efConstruction > 3200) {
+		invalid = append(invalid, "EfConstruction")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (o *DenseVectorIndexOptions) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"index_options": {
+	// 		"type": "hnsw",
+	// 		"m": 16,
+	// 		"ef_construction": 100
+	// 	}
+	// }
+	options := make(map[string]interface{})
+
+	if o.typ != "" {
+		options["type"] = o.typ
+	}
+	if o.m != nil {
+		options["m"] = o.m
+	}
+	if o.efConstruction != nil {
+		options["ef_construction"] = o.efConstruction
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source["index_options"] = options
+	return source, nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDenseVectorIndexOptionsSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		o           *DenseVectorIndexOptions
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name with Type.",
+			o:           NewDenseVectorIndexOptions("hnsw"),
+			includeName: true,
+			expected:    `{"index_options":{"type":"hnsw"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with M and EfConstruction.",
+			o:           NewDenseVectorIndexOptions("int8_hnsw").M(16).EfConstruction(100),
+			includeName: false,
+			expected:    `{"ef_construction":100,"m":16,"type":"int8_hnsw"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.o.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
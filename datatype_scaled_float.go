@@ -17,14 +17,15 @@ type DatatypeScaledFloat struct {
 	copyTo []string
 
 	// fields specific to scaled float datatype
-	coerce          *bool
-	boost           *float32
-	docValues       *bool
-	ignoreMalformed *bool
-	index           *bool
-	nullValue       *int
-	store           *bool
-	scalingFactor   *int
+	coerce           *bool
+	boost            *float32
+	docValues        *bool
+	ignoreMalformed  *bool
+	index            *bool
+	nullValue        *float64
+	store            *bool
+	scalingFactor    *int
+	timeSeriesMetric string
 }
 
 // NewDatatypeScaledFloat initializes a new DatatypeScaledFloat.
@@ -98,12 +99,12 @@ func (sf *DatatypeScaledFloat) Index(index bool) *DatatypeScaledFloat {
 	return sf
 }
 
-// NullValue sets a numeric value which is substituted for any explicit null values.
-// Defaults to null.
+// NullValue sets a numeric value which is substituted for any explicit null values,
+// rounded according to the scaling factor, see Preview. Defaults to null.
 //
 // See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/null-value.html
 // for details.
-func (sf *DatatypeScaledFloat) NullValue(nullValue int) *DatatypeScaledFloat {
+func (sf *DatatypeScaledFloat) NullValue(nullValue float64) *DatatypeScaledFloat {
 	sf.nullValue = &nullValue
 	return sf
 }
@@ -124,14 +125,64 @@ func (sf *DatatypeScaledFloat) ScalingFactor(scalingFactor int) *DatatypeScaledF
 	return sf
 }
 
+// Preview returns the values as stored by the field, multiplied by the scaling factor,
+// rounded to the closest long value and divided back, eg 1.2345 is stored as 1.23 with a
+// scaling factor of 100. The values are returned unchanged when the scaling factor is not set.
+func (sf *DatatypeScaledFloat) Preview(values ...float64) []float64 {
+	preview := make([]float64, 0, len(values))
+	for _, v := range values {
+		if sf.scalingFactor != nil && *sf.scalingFactor > 0 {
+			v = scaledFloatValue(v, float64(*sf.scalingFactor))
+		}
+		preview = append(preview, v)
+	}
+	return preview
+}
+
+// PrecisionWarnings returns warnings for the values which are rounded by the scaling factor,
+// including the null value.
+func (sf *DatatypeScaledFloat) PrecisionWarnings(values ...float64) []string {
+	if sf.nullValue != nil {
+		values = append([]float64{*sf.nullValue}, values...)
+	}
+	var warnings []string
+	for i, stored := range sf.Preview(values...) {
+		if stored != values[i] {
+			warnings = append(warnings, fmt.Sprintf("value %v is stored as %v by scaled_float field [%s]", values[i], stored, sf.name))
+		}
+	}
+	return warnings
+}
+
+// TimeSeriesMetric sets the time series metric type of the field, which can be set to "gauge"
+// or "counter". Metric fields cannot be time series dimensions.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-metric
+// for details.
+func (sf *DatatypeScaledFloat) TimeSeriesMetric(timeSeriesMetric string) *DatatypeScaledFloat {
+	sf.timeSeriesMetric = timeSeriesMetric
+	return sf
+}
+
 // Validate validates DatatypeScaledFloat.
 func (sf *DatatypeScaledFloat) Validate(includeName bool) error {
 	var invalid []string
 	if includeName && sf.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if sf.scalingFactor == nil || *sf.scalingFactor <= 0 {
+		invalid = append(invalid, "ScalingFactor")
+	}
+	if sf.nullValue != nil && !isFinite(*sf.nullValue) {
+		invalid = append(invalid, "NullValue")
+	}
+	if sf.timeSeriesMetric != "" {
+		if valid := validTimeSeriesMetrics[sf.timeSeriesMetric]; !valid {
+			invalid = append(invalid, "TimeSeriesMetric")
+		}
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
@@ -149,7 +200,8 @@ func (sf *DatatypeScaledFloat) Source(includeName bool) (interface{}, error) {
 	// 		"index": true,
 	// 		"null_value": 0,
 	// 		"store": true,
-	// 		"scaling_factor": 2
+	// 		"scaling_factor": 2,
+	// 		"time_series_metric": "gauge"
 	// 	}
 	// }
 	options := make(map[string]interface{})
@@ -193,6 +245,9 @@ func (sf *DatatypeScaledFloat) Source(includeName bool) (interface{}, error) {
 	if sf.scalingFactor != nil {
 		options["scaling_factor"] = sf.scalingFactor
 	}
+	if sf.timeSeriesMetric != "" {
+		options["time_series_metric"] = sf.timeSeriesMetric
+	}
 
 	if !includeName {
 		return options, nil
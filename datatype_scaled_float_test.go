@@ -6,6 +6,7 @@ package estemplate
 
 import (
 	"encoding/json"
+	"reflect"
 	"testing"
 )
 
@@ -30,6 +31,13 @@ func TestDatatypeScaledFloatSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"coerce":true,"index":true,"type":"scaled_float"}`,
 		},
+		// #2
+		{
+			desc:        "Exclude Name with NullValue.",
+			sf:          NewDatatypeScaledFloat("test").ScalingFactor(100).NullValue(0.25),
+			includeName: false,
+			expected:    `{"null_value":0.25,"scaling_factor":100,"type":"scaled_float"}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -46,4 +54,21 @@ func TestDatatypeScaledFloatSerialization(t *testing.T) {
 			}
 		})
 	}
+	if err := NewDatatypeScaledFloat("test").NullValue(0.25).Validate(true); err == nil {
+		t.Error("expected DatatypeScaledFloat validation error, got nil")
+	}
+}
+
+func TestDatatypeScaledFloatPreview(t *testing.T) {
+	sf := NewDatatypeScaledFloat("test").ScalingFactor(100).NullValue(0.125)
+	if got, expected := sf.Preview(1.2345, 2.5, -0.005), []float64{1.23, 2.5, 0}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected\n%v\n,got:\n%v", expected, got)
+	}
+	expected := []string{
+		"value 0.125 is stored as 0.13 by scaled_float field [test]",
+		"value 1.2345 is stored as 1.23 by scaled_float field [test]",
+	}
+	if got := sf.PrecisionWarnings(1.2345, 2.5); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected\n%v\n,got:\n%v", expected, got)
+	}
 }
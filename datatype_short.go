@@ -4,7 +4,10 @@
 
 package estemplate
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
 // DatatypeShort Core Datatype for numeric value.
 // A signed 16-bit integer with a minimum value of -32,768 and a maximum value of 32,767.
@@ -17,13 +20,15 @@ type DatatypeShort struct {
 	copyTo []string
 
 	// fields specific to short datatype
-	coerce          *bool
-	boost           *float32
-	docValues       *bool
-	ignoreMalformed *bool
-	index           *bool
-	nullValue       *int
-	store           *bool
+	coerce              *bool
+	boost               *float32
+	docValues           *bool
+	ignoreMalformed     *bool
+	index               *bool
+	nullValue           *int
+	store               *bool
+	timeSeriesDimension *bool
+	timeSeriesMetric    string
 }
 
 // NewDatatypeShort initializes a new DatatypeShort.
@@ -97,8 +102,8 @@ func (s *DatatypeShort) Index(index bool) *DatatypeShort {
 	return s
 }
 
-// NullValue sets a numeric value which is substituted for any explicit null values.
-// Defaults to null.
+// NullValue sets a numeric value which is substituted for any explicit null values,
+// within the short range [-32768, 32767]. Defaults to null.
 //
 // See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/null-value.html
 // for details.
@@ -117,14 +122,46 @@ func (s *DatatypeShort) Store(store bool) *DatatypeShort {
 	return s
 }
 
+// TimeSeriesDimension sets whether the field is a time series dimension, identifying together
+// with the other dimensions the time series of the documents of `time_series` indices.
+// Defaults to false.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-dimension
+// for details.
+func (s *DatatypeShort) TimeSeriesDimension(timeSeriesDimension bool) *DatatypeShort {
+	s.timeSeriesDimension = &timeSeriesDimension
+	return s
+}
+
+// TimeSeriesMetric sets the time series metric type of the field, which can be set to "gauge"
+// or "counter". Metric fields cannot be time series dimensions.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-metric
+// for details.
+func (s *DatatypeShort) TimeSeriesMetric(timeSeriesMetric string) *DatatypeShort {
+	s.timeSeriesMetric = timeSeriesMetric
+	return s
+}
+
 // Validate validates DatatypeShort.
 func (s *DatatypeShort) Validate(includeName bool) error {
 	var invalid []string
 	if includeName && s.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if s.nullValue != nil && (*s.nullValue < math.MinInt16 || *s.nullValue > math.MaxInt16) {
+		invalid = append(invalid, "NullValue")
+	}
+	if s.timeSeriesMetric != "" {
+		if valid := validTimeSeriesMetrics[s.timeSeriesMetric]; !valid {
+			invalid = append(invalid, "TimeSeriesMetric")
+		}
+	}
+	if s.timeSeriesMetric != "" && s.timeSeriesDimension != nil && *s.timeSeriesDimension {
+		invalid = append(invalid, "TimeSeriesMetric")
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
@@ -141,7 +178,9 @@ func (s *DatatypeShort) Source(includeName bool) (interface{}, error) {
 	// 		"ignore_malformed": true,
 	// 		"index": true,
 	// 		"null_value": 0,
-	// 		"store": true
+	// 		"store": true,
+	// 		"time_series_dimension": true,
+	// 		"time_series_metric": "gauge"
 	// 	}
 	// }
 	options := make(map[string]interface{})
@@ -182,6 +221,12 @@ func (s *DatatypeShort) Source(includeName bool) (interface{}, error) {
 	if s.store != nil {
 		options["store"] = s.store
 	}
+	if s.timeSeriesDimension != nil {
+		options["time_series_dimension"] = s.timeSeriesDimension
+	}
+	if s.timeSeriesMetric != "" {
+		options["time_series_metric"] = s.timeSeriesMetric
+	}
 
 	if !includeName {
 		return options, nil
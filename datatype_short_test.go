@@ -30,6 +30,13 @@ func TestDatatypeShortSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"coerce":true,"index":true,"type":"short"}`,
 		},
+		// #2
+		{
+			desc:        "Exclude Name with NullValue.",
+			s:           NewDatatypeShort("test").NullValue(32767),
+			includeName: false,
+			expected:    `{"null_value":32767,"type":"short"}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -46,4 +53,7 @@ func TestDatatypeShortSerialization(t *testing.T) {
 			}
 		})
 	}
+	if err := NewDatatypeShort("test").NullValue(-32769).Validate(true); err == nil {
+		t.Error("expected DatatypeShort validation error, got nil")
+	}
 }
@@ -0,0 +1,223 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// DatatypeUnsignedLong Core Datatype for numeric value.
+// An unsigned 64-bit integer with a minimum value of 0 and a maximum value of 2⁶⁴-1.
+// Requires Elasticsearch 7.10 or later.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.10/number.html
+// for details.
+type DatatypeUnsignedLong struct {
+	Datatype
+	name   string
+	copyTo []string
+
+	// fields specific to unsigned long datatype
+	boost               *float32
+	docValues           *bool
+	ignoreMalformed     *bool
+	index               *bool
+	nullValue           *uint64
+	store               *bool
+	timeSeriesDimension *bool
+	timeSeriesMetric    string
+}
+
+// NewDatatypeUnsignedLong initializes a new DatatypeUnsignedLong.
+func NewDatatypeUnsignedLong(name string) *DatatypeUnsignedLong {
+	return &DatatypeUnsignedLong{
+		name: name,
+	}
+}
+
+// Name returns field key for the Datatype.
+func (ul *DatatypeUnsignedLong) Name() string {
+	return ul.name
+}
+
+// MinimumVersion returns the minimum Elasticsearch version supporting the Datatype.
+func (ul *DatatypeUnsignedLong) MinimumVersion() string {
+	return "7.10.0"
+}
+
+// CopyTo sets the field(s) to copy to which allows the values of multiple fields to be
+// queried as a single field.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.10/copy-to.html
+// for details.
+func (ul *DatatypeUnsignedLong) CopyTo(copyTo ...string) *DatatypeUnsignedLong {
+	ul.copyTo = append(ul.copyTo, copyTo...)
+	return ul
+}
+
+// Boost sets Mapping field-level query time boosting. Defaults to 1.0.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.10/mapping-boost.html
+// for details.
+func (ul *DatatypeUnsignedLong) Boost(boost float32) *DatatypeUnsignedLong {
+	ul.boost = &boost
+	return ul
+}
+
+// DocValues sets whether if the field should be stored on disk in a column-stride fashion
+// so that it can later be used for sorting, aggregations, or scripting.
+// Defaults to true.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.10/doc-values.html
+// for details.
+func (ul *DatatypeUnsignedLong) DocValues(docValues bool) *DatatypeUnsignedLong {
+	ul.docValues = &docValues
+	return ul
+}
+
+// IgnoreMalformed sets whether if the field should ignore malformed numbers.
+// Defaults to false.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.10/ignore-malformed.html
+// for details.
+func (ul *DatatypeUnsignedLong) IgnoreMalformed(ignoreMalformed bool) *DatatypeUnsignedLong {
+	ul.ignoreMalformed = &ignoreMalformed
+	return ul
+}
+
+// Index sets whether if the field should be searchable. Defaults to true.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.10/mapping-index.html
+// for details.
+func (ul *DatatypeUnsignedLong) Index(index bool) *DatatypeUnsignedLong {
+	ul.index = &index
+	return ul
+}
+
+// NullValue sets a numeric value which is substituted for any explicit null values,
+// which can be any value up to 18446744073709551615. Defaults to null.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.10/null-value.html
+// for details.
+func (ul *DatatypeUnsignedLong) NullValue(nullValue uint64) *DatatypeUnsignedLong {
+	ul.nullValue = &nullValue
+	return ul
+}
+
+// Store sets whether if the field value should be stored and retrievable separately
+// from the `_source` field. Defaults to false.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.10/mapping-store.html
+// for details.
+func (ul *DatatypeUnsignedLong) Store(store bool) *DatatypeUnsignedLong {
+	ul.store = &store
+	return ul
+}
+
+// TimeSeriesDimension sets whether the field is a time series dimension, identifying together
+// with the other dimensions the time series of the documents of `time_series` indices.
+// Defaults to false.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-dimension
+// for details.
+func (ul *DatatypeUnsignedLong) TimeSeriesDimension(timeSeriesDimension bool) *DatatypeUnsignedLong {
+	ul.timeSeriesDimension = &timeSeriesDimension
+	return ul
+}
+
+// TimeSeriesMetric sets the time series metric type of the field, which can be set to "gauge"
+// or "counter". Metric fields cannot be time series dimensions.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html#time-series-metric
+// for details.
+func (ul *DatatypeUnsignedLong) TimeSeriesMetric(timeSeriesMetric string) *DatatypeUnsignedLong {
+	ul.timeSeriesMetric = timeSeriesMetric
+	return ul
+}
+
+// Validate validates DatatypeUnsignedLong.
+func (ul *DatatypeUnsignedLong) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && ul.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if ul.timeSeriesMetric != "" {
+		if valid := validTimeSeriesMetrics[ul.timeSeriesMetric]; !valid {
+			invalid = append(invalid, "TimeSeriesMetric")
+		}
+	}
+	if ul.timeSeriesMetric != "" && ul.timeSeriesDimension != nil && *ul.timeSeriesDimension {
+		invalid = append(invalid, "TimeSeriesMetric")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (ul *DatatypeUnsignedLong) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "unsigned_long",
+	// 		"copy_to": ["field_1", "field_2"],
+	// 		"boost": 2,
+	// 		"doc_values": true,
+	// 		"ignore_malformed": true,
+	// 		"index": true,
+	// 		"null_value": 18446744073709551615,
+	// 		"store": true,
+	// 		"time_series_dimension": true,
+	// 		"time_series_metric": "gauge"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "unsigned_long"
+
+	if len(ul.copyTo) > 0 {
+		var copyTo interface{}
+		switch {
+		case len(ul.copyTo) > 1:
+			copyTo = ul.copyTo
+			break
+		case len(ul.copyTo) == 1:
+			copyTo = ul.copyTo[0]
+			break
+		default:
+			copyTo = ""
+		}
+		options["copy_to"] = copyTo
+	}
+	if ul.boost != nil {
+		options["boost"] = ul.boost
+	}
+	if ul.docValues != nil {
+		options["doc_values"] = ul.docValues
+	}
+	if ul.ignoreMalformed != nil {
+		options["ignore_malformed"] = ul.ignoreMalformed
+	}
+	if ul.index != nil {
+		options["index"] = ul.index
+	}
+	if ul.nullValue != nil {
+		options["null_value"] = ul.nullValue
+	}
+	if ul.store != nil {
+		options["store"] = ul.store
+	}
+	if ul.timeSeriesDimension != nil {
+		options["time_series_dimension"] = ul.timeSeriesDimension
+	}
+	if ul.timeSeriesMetric != "" {
+		options["time_series_metric"] = ul.timeSeriesMetric
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[ul.name] = options
+	return source, nil
+}
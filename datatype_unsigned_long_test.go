@@ -0,0 +1,50 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestDatatypeUnsignedLongSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		ul          *DatatypeUnsignedLong
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name with Index.",
+			ul:          NewDatatypeUnsignedLong("test").Index(true),
+			includeName: true,
+			expected:    `{"test":{"index":true,"type":"unsigned_long"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with NullValue.",
+			ul:          NewDatatypeUnsignedLong("test").NullValue(math.MaxUint64),
+			includeName: false,
+			expected:    `{"null_value":18446744073709551615,"type":"unsigned_long"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.ul.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// DatatypeVersion Specialised Datatype for software version values following the
+// Semantic Versioning rules, which supports exact, range, prefix and wildcard queries
+// ordered by version precedence. Requires Elasticsearch 7.10 or later.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.10/version.html
+// for details.
+type DatatypeVersion struct {
+	Datatype
+	name   string
+	copyTo []string
+}
+
+// NewDatatypeVersion initializes a new DatatypeVersion.
+func NewDatatypeVersion(name string) *DatatypeVersion {
+	return &DatatypeVersion{
+		name: name,
+	}
+}
+
+// Name returns field key for the Datatype.
+func (v *DatatypeVersion) Name() string {
+	return v.name
+}
+
+// MinimumVersion returns the minimum Elasticsearch version supporting the Datatype.
+func (v *DatatypeVersion) MinimumVersion() string {
+	return "7.10.0"
+}
+
+// CopyTo sets the field(s) to copy to which allows the values of multiple fields to be
+// queried as a single field.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.10/copy-to.html
+// for details.
+func (v *DatatypeVersion) CopyTo(copyTo ...string) *DatatypeVersion {
+	v.copyTo = append(v.copyTo, copyTo...)
+	return v
+}
+
+// Validate validates DatatypeVersion.
+func (v *DatatypeVersion) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && v.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (v *DatatypeVersion) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "version",
+	// 		"copy_to": ["field_1", "field_2"]
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "version"
+
+	if len(v.copyTo) > 0 {
+		var copyTo interface{}
+		switch {
+		case len(v.copyTo) > 1:
+			copyTo = v.copyTo
+			break
+		case len(v.copyTo) == 1:
+			copyTo = v.copyTo[0]
+			break
+		default:
+			copyTo = ""
+		}
+		options["copy_to"] = copyTo
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[v.name] = options
+	return source, nil
+}
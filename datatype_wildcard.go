@@ -0,0 +1,128 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// DatatypeWildcard Core Datatype for unstructured machine-generated content
+// such as log lines, optimised for `wildcard` and `regexp` queries over large
+// string values. Requires Elasticsearch 7.9 or later.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.9/keyword.html#wildcard-field-type
+// for details.
+type DatatypeWildcard struct {
+	Datatype
+	name   string
+	copyTo []string
+
+	// fields specific to wildcard datatype
+	ignoreAbove *int
+	nullValue   string
+}
+
+// NewDatatypeWildcard initializes a new DatatypeWildcard.
+func NewDatatypeWildcard(name string) *DatatypeWildcard {
+	return &DatatypeWildcard{
+		name: name,
+	}
+}
+
+// Name returns field key for the Datatype.
+func (w *DatatypeWildcard) Name() string {
+	return w.name
+}
+
+// MinimumVersion returns the minimum Elasticsearch version supporting the Datatype.
+func (w *DatatypeWildcard) MinimumVersion() string {
+	return "7.9.0"
+}
+
+// CopyTo sets the field(s) to copy to which allows the values of multiple fields to be
+// queried as a single field.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.9/copy-to.html
+// for details.
+func (w *DatatypeWildcard) CopyTo(copyTo ...string) *DatatypeWildcard {
+	w.copyTo = append(w.copyTo, copyTo...)
+	return w
+}
+
+// IgnoreAbove sets the limit of the string length, strings longer than it will not be
+// indexed. Defaults to 2147483647.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.9/ignore-above.html
+// for details.
+func (w *DatatypeWildcard) IgnoreAbove(ignoreAbove int) *DatatypeWildcard {
+	w.ignoreAbove = &ignoreAbove
+	return w
+}
+
+// NullValue sets a string value which is substituted for any explicit null values.
+// Defaults to null.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.9/null-value.html
+// for details.
+func (w *DatatypeWildcard) NullValue(nullValue string) *DatatypeWildcard {
+	w.nullValue = nullValue
+	return w
+}
+
+// Validate validates DatatypeWildcard.
+func (w *DatatypeWildcard) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && w.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if w.ignoreAbove != nil && *w.ignoreAbove < 0 {
+		invalid = append(invalid, "IgnoreAbove")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (w *DatatypeWildcard) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "wildcard",
+	// 		"copy_to": ["field_1", "field_2"],
+	// 		"ignore_above": 256,
+	// 		"null_value": "NULL"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "wildcard"
+
+	if len(w.copyTo) > 0 {
+		var copyTo interface{}
+		switch {
+		case len(w.copyTo) > 1:
+			copyTo = w.copyTo
+			break
+		case len(w.copyTo) == 1:
+			copyTo = w.copyTo[0]
+			break
+		default:
+			copyTo = ""
+		}
+		options["copy_to"] = copyTo
+	}
+	if w.ignoreAbove != nil {
+		options["ignore_above"] = w.ignoreAbove
+	}
+	if w.nullValue != "" {
+		options["null_value"] = w.nullValue
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[w.name] = options
+	return source, nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDatatypeWildcardSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		w           *DatatypeWildcard
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name with IgnoreAbove.",
+			w:           NewDatatypeWildcard("test").IgnoreAbove(256),
+			includeName: true,
+			expected:    `{"test":{"ignore_above":256,"type":"wildcard"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with CopyTo and NullValue.",
+			w:           NewDatatypeWildcard("test").CopyTo("all").NullValue("NULL"),
+			includeName: false,
+			expected:    `{"copy_to":"all","null_value":"NULL","type":"wildcard"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.w.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
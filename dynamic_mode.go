@@ -0,0 +1,76 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+// DynamicMode controls whether new fields detected in a document are added to the mapping,
+// used in the `dynamic` parameter of the mappings, `object` datatype and `nested` datatype.
+// Inner objects inherit the setting from their parent object or from the mapping type.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.11/dynamic.html
+// for details.
+type DynamicMode string
+
+// Dynamic modes.
+const (
+	// DynamicModeTrue adds new fields to the mapping.
+	DynamicModeTrue DynamicMode = "true"
+	// DynamicModeFalse ignores new fields, they are not indexed or searchable but still appear
+	// in the `_source` field.
+	DynamicModeFalse DynamicMode = "false"
+	// DynamicModeStrict throws an exception and rejects the document if new fields are detected.
+	DynamicModeStrict DynamicMode = "strict"
+	// DynamicModeRuntime adds new fields to the mapping as runtime fields, which are not indexed
+	// but loaded from `_source` at query time. Requires Elasticsearch 7.11 or later.
+	DynamicModeRuntime DynamicMode = "runtime"
+)
+
+// validDynamicModes dynamic modes accepted by Elasticsearch.
+var validDynamicModes = map[DynamicMode]bool{
+	DynamicModeTrue:    true,
+	DynamicModeFalse:   true,
+	DynamicModeStrict:  true,
+	DynamicModeRuntime: true,
+}
+
+// value returns the serializable value of the dynamic mode, true and false being serialized
+// as booleans.
+func (d DynamicMode) value() interface{} {
+	switch d {
+	case DynamicModeTrue:
+		return true
+	case DynamicModeFalse:
+		return false
+	}
+	return string(d)
+}
+
+// resolveDynamicMode resolves the dynamic mode set through the `Dynamic`, `Strict` and
+// `DynamicMode` setters of a datatype, the typed dynamic mode taking precedence over strict,
+// which takes precedence over the boolean setting. Returns an empty mode when none is set.
+func resolveDynamicMode(dynamic *bool, strict bool, mode DynamicMode) DynamicMode {
+	switch {
+	case mode != "":
+		return mode
+	case strict:
+		return DynamicModeStrict
+	case dynamic != nil && *dynamic:
+		return DynamicModeTrue
+	case dynamic != nil:
+		return DynamicModeFalse
+	}
+	return ""
+}
+
+// hasSubobjects returns whether any of the properties is an `object` or `nested` datatype,
+// which is rejected by objects with `subobjects` disabled.
+func hasSubobjects(properties []Datatype) bool {
+	for _, p := range properties {
+		switch p.(type) {
+		case *DatatypeObject, *DatatypeNested:
+			return true
+		}
+	}
+	return false
+}
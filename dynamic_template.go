@@ -114,8 +114,23 @@ func (t *DynamicTemplate) Validate(includeName bool) error {
 	if includeName && t.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if _, valid := map[string]bool{
+		"":       true,
+		"simple": true,
+		"regex":  true,
+	}[t.matchPattern]; !valid {
+		invalid = append(invalid, "MatchPattern")
+	}
+	if t.matchPattern == "regex" {
+		for _, pattern := range []string{t.match, t.unmatch, t.pathMatch, t.pathUnmatch} {
+			if err := ValidateJavaRegex(pattern); err != nil {
+				invalid = append(invalid, "Match")
+				break
+			}
+		}
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
@@ -92,6 +92,22 @@ const (
 	Flattened
 	// Shape Datatype
 	Shape
+	// ICUCollationKeyword Datatype
+	ICUCollationKeyword
+	// Wildcard Datatype
+	Wildcard
+	// ConstantKeyword Datatype
+	ConstantKeyword
+	// Version Datatype
+	Version
+	// MatchOnlyText Datatype
+	MatchOnlyText
+	// UnsignedLong Datatype
+	UnsignedLong
+	// Histogram Datatype
+	Histogram
+	// AggregateMetricDouble Datatype
+	AggregateMetricDouble
 )
 
 // Decode decode datatype from string value.
@@ -173,6 +189,22 @@ func (d *Datatype) Decode(value string) error {
 		*d = Flattened
 	case "shape":
 		*d = Shape
+	case "icu_collation_keyword":
+		*d = ICUCollationKeyword
+	case "wildcard":
+		*d = Wildcard
+	case "constant_keyword":
+		*d = ConstantKeyword
+	case "version":
+		*d = Version
+	case "match_only_text":
+		*d = MatchOnlyText
+	case "unsigned_long":
+		*d = UnsignedLong
+	case "histogram":
+		*d = Histogram
+	case "aggregate_metric_double":
+		*d = AggregateMetricDouble
 	default:
 		*d = Invalid
 	}
@@ -186,8 +218,10 @@ func (d *Datatype) DecodeByKind(k reflect.Kind) error {
 		*d = Boolean
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		fallthrough
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uintptr:
 		*d = Integer
+	case reflect.Uint64:
+		*d = UnsignedLong
 	case reflect.Float32, reflect.Float64:
 		fallthrough
 	case reflect.Complex64, reflect.Complex128:
@@ -214,43 +248,51 @@ func (d *Datatype) String() string {
 }
 
 var datatypeNames = []string{
-	Invalid:             "invalid",
-	Text:                "text",
-	Keyword:             "keyword",
-	Long:                "long",
-	Integer:             "integer",
-	Short:               "short",
-	Byte:                "byte",
-	Double:              "double",
-	Float:               "float",
-	HalfFloat:           "half_float",
-	ScaledFloat:         "scaled_float",
-	Date:                "date",
-	DateNanoseconds:     "date_nanoseconds",
-	Boolean:             "boolean",
-	Binary:              "binary",
-	IntegerRange:        "integer_range",
-	FloatRange:          "float_range",
-	LongRange:           "long_range",
-	DoubleRange:         "double_range",
-	DateRange:           "date_range",
-	Object:              "object",
-	Nested:              "nested",
-	GeoPoint:            "geo_point",
-	GeoShape:            "geo_shape",
-	IP:                  "ip",
-	Completion:          "completion",
-	TokenCount:          "token_count",
-	MapperMurmur3:       "mapper_murmur3",
-	MapperAnnotatedText: "mapper_annotated_text",
-	Percolator:          "percolator",
-	Join:                "join",
-	RankFeature:         "rank_feature",
-	RankFeatures:        "rank_features",
-	DenseVector:         "dense_vector",
-	SparseVector:        "sparse_vector",
-	SearchAsYouType:     "search_as_you_type",
-	Alias:               "alias",
-	Flattened:           "flattened",
-	Shape:               "shape",
+	Invalid:               "invalid",
+	Text:                  "text",
+	Keyword:               "keyword",
+	Long:                  "long",
+	Integer:               "integer",
+	Short:                 "short",
+	Byte:                  "byte",
+	Double:                "double",
+	Float:                 "float",
+	HalfFloat:             "half_float",
+	ScaledFloat:           "scaled_float",
+	Date:                  "date",
+	DateNanoseconds:       "date_nanoseconds",
+	Boolean:               "boolean",
+	Binary:                "binary",
+	IntegerRange:          "integer_range",
+	FloatRange:            "float_range",
+	LongRange:             "long_range",
+	DoubleRange:           "double_range",
+	DateRange:             "date_range",
+	Object:                "object",
+	Nested:                "nested",
+	GeoPoint:              "geo_point",
+	GeoShape:              "geo_shape",
+	IP:                    "ip",
+	Completion:            "completion",
+	TokenCount:            "token_count",
+	MapperMurmur3:         "mapper_murmur3",
+	MapperAnnotatedText:   "mapper_annotated_text",
+	Percolator:            "percolator",
+	Join:                  "join",
+	RankFeature:           "rank_feature",
+	RankFeatures:          "rank_features",
+	DenseVector:           "dense_vector",
+	SparseVector:          "sparse_vector",
+	SearchAsYouType:       "search_as_you_type",
+	Alias:                 "alias",
+	Flattened:             "flattened",
+	Shape:                 "shape",
+	ICUCollationKeyword:   "icu_collation_keyword",
+	Wildcard:              "wildcard",
+	ConstantKeyword:       "constant_keyword",
+	Version:               "version",
+	MatchOnlyText:         "match_only_text",
+	UnsignedLong:          "unsigned_long",
+	Histogram:             "histogram",
+	AggregateMetricDouble: "aggregate_metric_double",
 }
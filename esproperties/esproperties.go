@@ -73,6 +73,18 @@ func getTypeElem(t reflect.Type, isNested bool) (reflect.Type, bool) {
 	return t, isNested
 }
 
+// getDenseVectorDims returns the length of fixed size float32 arrays, eg [128]float32, which
+// are mapped to dense vectors.
+func getDenseVectorDims(t reflect.Type) (int, bool) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Float32 {
+		return t.Len(), true
+	}
+	return 0, false
+}
+
 // getValueElem returns value of interface contains / pointer points to.
 func getValueElem(v reflect.Value) reflect.Value {
 	kind := v.Kind()
@@ -129,7 +141,9 @@ func generateDatatypes(t reflect.Type, nestedCount, nestedLimit int, builder Bui
 			dt  Datatype
 			err error
 		)
-		if _dt == "" {
+		if _, isDenseVector := getDenseVectorDims(field.Type); _dt == "" && isDenseVector {
+			dt = DenseVector
+		} else if _dt == "" {
 			t, isNested := getTypeElem(field.Type, false)
 			if isNested {
 				err = dt.DecodeByKind(reflect.Slice)
@@ -224,7 +238,11 @@ func generateDatatypes(t reflect.Type, nestedCount, nestedLimit int, builder Bui
 		case RankFeatures:
 			datatype = builder(name, nestedCount, dt, estemplate.NewDatatypeRankFeatures(name))
 		case DenseVector:
-			datatype = builder(name, nestedCount, dt, estemplate.NewDatatypeDenseVector(name))
+			denseVector := estemplate.NewDatatypeDenseVector(name)
+			if dims, ok := getDenseVectorDims(field.Type); ok {
+				denseVector.Dims(dims)
+			}
+			datatype = builder(name, nestedCount, dt, denseVector)
 		case SparseVector:
 			datatype = builder(name, nestedCount, dt, estemplate.NewDatatypeSparseVector(name))
 		case SearchAsYouType:
@@ -235,6 +253,22 @@ func generateDatatypes(t reflect.Type, nestedCount, nestedLimit int, builder Bui
 			datatype = builder(name, nestedCount, dt, estemplate.NewDatatypeFlattened(name))
 		case Shape:
 			datatype = builder(name, nestedCount, dt, estemplate.NewDatatypeShape(name))
+		case ICUCollationKeyword:
+			datatype = builder(name, nestedCount, dt, estemplate.NewDatatypeICUCollationKeyword(name))
+		case Wildcard:
+			datatype = builder(name, nestedCount, dt, estemplate.NewDatatypeWildcard(name))
+		case ConstantKeyword:
+			datatype = builder(name, nestedCount, dt, estemplate.NewDatatypeConstantKeyword(name))
+		case Version:
+			datatype = builder(name, nestedCount, dt, estemplate.NewDatatypeVersion(name))
+		case MatchOnlyText:
+			datatype = builder(name, nestedCount, dt, estemplate.NewDatatypeMatchOnlyText(name))
+		case UnsignedLong:
+			datatype = builder(name, nestedCount, dt, estemplate.NewDatatypeUnsignedLong(name))
+		case Histogram:
+			datatype = builder(name, nestedCount, dt, estemplate.NewDatatypeHistogram(name))
+		case AggregateMetricDouble:
+			datatype = builder(name, nestedCount, dt, estemplate.NewDatatypeAggregateMetricDouble(name))
 		case Invalid:
 		default:
 			return nil, fmt.Errorf("Undefined Datatype '%s' for field '%s'", t, field.Name)
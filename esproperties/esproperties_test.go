@@ -452,7 +452,7 @@ func TestESPropertiesNewSerialization(t *testing.T) {
 		{
 			builder:     DefaultBuilder,
 			desc:        "Dynamic Datatype test",
-			expected:    `{"array":{"type":"nested"},"boolean":{"type":"boolean"},"complex_128":{"type":"float"},"complex_64":{"type":"float"},"float_32":{"type":"float"},"float_64":{"type":"float"},"integer":{"type":"integer"},"integer_16":{"type":"integer"},"integer_32":{"type":"integer"},"integer_64":{"type":"integer"},"integer_8":{"type":"integer"},"slice":{"type":"nested"},"string":{"type":"text"},"struct":{"type":"object"},"uinteger":{"type":"integer"},"uinteger_16":{"type":"integer"},"uinteger_32":{"type":"integer"},"uinteger_64":{"type":"integer"},"uinteger_8":{"type":"integer"},"uinteger_pointer":{"type":"integer"}}`,
+			expected:    `{"array":{"type":"nested"},"boolean":{"type":"boolean"},"complex_128":{"type":"float"},"complex_64":{"type":"float"},"float_32":{"type":"float"},"float_64":{"type":"float"},"integer":{"type":"integer"},"integer_16":{"type":"integer"},"integer_32":{"type":"integer"},"integer_64":{"type":"integer"},"integer_8":{"type":"integer"},"slice":{"type":"nested"},"string":{"type":"text"},"struct":{"type":"object"},"uinteger":{"type":"integer"},"uinteger_16":{"type":"integer"},"uinteger_32":{"type":"integer"},"uinteger_64":{"type":"unsigned_long"},"uinteger_8":{"type":"integer"},"uinteger_pointer":{"type":"integer"}}`,
 			nestedLimit: 1,
 			origin: struct {
 				Boolean         bool                   `es:"boolean"`
@@ -519,6 +519,97 @@ func TestESPropertiesNewSerialization(t *testing.T) {
 				Nested nestedTest `es:"nested,nested"`
 			}{},
 		},
+		// #43
+		{
+			builder:     DefaultBuilder,
+			desc:        "ICUCollationKeyword Datatype test",
+			expected:    `{"icu_collation_keyword":{"type":"icu_collation_keyword"}}`,
+			nestedLimit: 1,
+			origin: struct {
+				ICUCollationKeyword string `es:"icu_collation_keyword,icu_collation_keyword"`
+			}{},
+		},
+		// #44
+		{
+			builder:     DefaultBuilder,
+			desc:        "Wildcard Datatype test",
+			expected:    `{"wildcard":{"type":"wildcard"}}`,
+			nestedLimit: 1,
+			origin: struct {
+				Wildcard string `es:"wildcard,wildcard"`
+			}{},
+		},
+		// #45
+		{
+			builder:     DefaultBuilder,
+			desc:        "ConstantKeyword Datatype test",
+			expected:    `{"constant_keyword":{"type":"constant_keyword"}}`,
+			nestedLimit: 1,
+			origin: struct {
+				ConstantKeyword string `es:"constant_keyword,constant_keyword"`
+			}{},
+		},
+		// #46
+		{
+			builder:     DefaultBuilder,
+			desc:        "Version Datatype test",
+			expected:    `{"version":{"type":"version"}}`,
+			nestedLimit: 1,
+			origin: struct {
+				Version string `es:"version,version"`
+			}{},
+		},
+		// #47
+		{
+			builder:     DefaultBuilder,
+			desc:        "MatchOnlyText Datatype test",
+			expected:    `{"match_only_text":{"type":"match_only_text"}}`,
+			nestedLimit: 1,
+			origin: struct {
+				MatchOnlyText string `es:"match_only_text,match_only_text"`
+			}{},
+		},
+		// #48
+		{
+			builder:     DefaultBuilder,
+			desc:        "UnsignedLong Datatype test",
+			expected:    `{"unsigned_long":{"type":"unsigned_long"}}`,
+			nestedLimit: 1,
+			origin: struct {
+				UnsignedLong string `es:"unsigned_long,unsigned_long"`
+			}{},
+		},
+		// #49
+		{
+			builder:     DefaultBuilder,
+			desc:        "Histogram Datatype test",
+			expected:    `{"histogram":{"type":"histogram"}}`,
+			nestedLimit: 1,
+			origin: struct {
+				Histogram string `es:"histogram,histogram"`
+			}{},
+		},
+		// #50
+		{
+			builder:     DefaultBuilder,
+			desc:        "AggregateMetricDouble Datatype test",
+			expected:    `{"aggregate_metric_double":{"type":"aggregate_metric_double"}}`,
+			nestedLimit: 1,
+			origin: struct {
+				AggregateMetricDouble string `es:"aggregate_metric_double,aggregate_metric_double"`
+			}{},
+		},
+		// #51
+		{
+			builder:     DefaultBuilder,
+			desc:        "DenseVector Datatype from float32 array test",
+			expected:    `{"embedding":{"dims":3,"type":"dense_vector"},"tagged_embedding":{"dims":128,"type":"dense_vector"}}`,
+			nestedLimit: 1,
+			origin: struct {
+				Embedding       [3]float32    `es:"embedding"`
+				TaggedEmbedding *[128]float32 `es:"tagged_embedding,dense_vector"`
+			}{},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -0,0 +1,627 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Meta data keys used to stamp fingerprints into MetaFieldMeta.
+const (
+	FingerprintMetaKey        = "fingerprint"
+	FingerprintVersionMetaKey = "fingerprint_version"
+)
+
+// settingDefaults default values of the index settings, keyed by flattened setting name.
+var settingDefaults = map[string]interface{}{
+	"number_of_shards":                         1,
+	"number_of_replicas":                       1,
+	"shard.check_on_startup":                   false,
+	"codec":                                    "default",
+	"routing_partition_size":                   1,
+	"load_fixed_bitset_filters_eagerly":        true,
+	"auto_expand_replicas":                     false,
+	"search.idle.after":                        "30s",
+	"refresh_interval":                         "1s",
+	"max_result_window":                        10000,
+	"max_inner_result_window":                  100,
+	"max_rescore_window":                       10000,
+	"max_docvalue_fields_search":               100,
+	"max_script_fields":                        32,
+	"max_ngram_diff":                           1,
+	"max_shingle_diff":                         3,
+	"blocks.read_only":                         false,
+	"blocks.read_only_allow_delete":            false,
+	"blocks.read":                              false,
+	"blocks.write":                             false,
+	"blocks.metadata":                          false,
+	"max_refresh_listeners":                    1000,
+	"analyze.max_token_count":                  10000,
+	"highlight.max_analyzed_offset":            1000000,
+	"max_terms_count":                          65536,
+	"max_regex_length":                         1000,
+	"routing.allocation.enable":                "all",
+	"routing.rebalance.enable":                 "all",
+	"gc_deletes":                               "60s",
+	"unassigned.node_left.delayed_timeout":     "1m",
+	"mapping.total_fields.limit":               1000,
+	"mapping.depth.limit":                      20,
+	"mapping.nested_fields.limit":              50,
+	"mapping.nested_objects.limit":             10000,
+	"translog.sync_interval":                   "5s",
+	"translog.durability":                      "request",
+	"translog.flush_threshold_size":            "512mb",
+	"soft_deletes.enabled":                     true,
+	"soft_deletes.retention_lease.period":      "12h",
+	"indexing.slowlog.source":                  1000,
+	"indexing.slowlog.reformat":                true,
+	"lifecycle.parse_origination_date":         false,
+	"routing.allocation.total_shards_per_node": -1,
+}
+
+// mappingsDefaults default values of the root mappings parameters and meta fields.
+var mappingsDefaults = map[string]interface{}{
+	"dynamic":           true,
+	"date_detection":    true,
+	"numeric_detection": false,
+	"_source":           map[string]interface{}{"enabled": true},
+	"_routing":          map[string]interface{}{"required": false},
+	"_size":             map[string]interface{}{"enabled": false},
+	"_field_names":      map[string]interface{}{"enabled": true},
+	"subobjects":        true,
+}
+
+// fieldDefaults default values of the field mapping parameters shared by all datatypes.
+var fieldDefaults = map[string]interface{}{
+	"index":                 true,
+	"store":                 false,
+	"boost":                 1,
+	"doc_values":            true,
+	"coerce":                true,
+	"ignore_malformed":      false,
+	"eager_global_ordinals": false,
+	"enabled":               true,
+}
+
+// datatypeDefaults default values of the field mapping parameters specific to a datatype.
+var datatypeDefaults = map[string]map[string]interface{}{
+	"text": {
+		"norms":                  true,
+		"index_options":          "positions",
+		"position_increment_gap": 100,
+		"term_vector":            "no",
+		"fielddata":              false,
+		"index_phrases":          false,
+		"similarity":             "BM25",
+	},
+	"keyword": {
+		"norms":                       false,
+		"index_options":               "docs",
+		"similarity":                  "BM25",
+		"split_queries_on_whitespace": false,
+	},
+	"date": {
+		"format": "strict_date_optional_time||epoch_millis",
+	},
+	"object": {
+		"type":       "object",
+		"subobjects": true,
+	},
+	"nested": {
+		"include_in_parent": false,
+		"include_in_root":   false,
+	},
+}
+
+// settingScalarKeys flattened index settings holding a number or a boolean.
+var settingScalarKeys = map[string]bool{
+	"number_of_shards":                         true,
+	"number_of_replicas":                       true,
+	"shard.check_on_startup":                   true,
+	"routing_partition_size":                   true,
+	"load_fixed_bitset_filters_eagerly":        true,
+	"auto_expand_replicas":                     true,
+	"max_result_window":                        true,
+	"max_inner_result_window":                  true,
+	"max_rescore_window":                       true,
+	"max_docvalue_fields_search":               true,
+	"max_script_fields":                        true,
+	"max_ngram_diff":                           true,
+	"max_shingle_diff":                         true,
+	"blocks.read_only":                         true,
+	"blocks.read_only_allow_delete":            true,
+	"blocks.read":                              true,
+	"blocks.write":                             true,
+	"blocks.metadata":                          true,
+	"max_refresh_listeners":                    true,
+	"analyze.max_token_count":                  true,
+	"highlight.max_analyzed_offset":            true,
+	"max_terms_count":                          true,
+	"max_regex_length":                         true,
+	"priority":                                 true,
+	"routing.allocation.total_shards_per_node": true,
+	"mapping.total_fields.limit":               true,
+	"mapping.depth.limit":                      true,
+	"mapping.nested_fields.limit":              true,
+	"mapping.nested_objects.limit":             true,
+	"mapping.field_name_length.limit":          true,
+	"mapping.dimension_fields.limit":           true,
+	"merge.scheduler.max_thread_count":         true,
+	"soft_deletes.enabled":                     true,
+	"indexing.slowlog.source":                  true,
+	"indexing.slowlog.reformat":                true,
+	"lifecycle.parse_origination_date":         true,
+	"lifecycle.origination_date":               true,
+}
+
+// mappingScalarKeys mapping parameters of the mappings, meta fields and fields holding a
+// number or a boolean. `null_value` is not part of them, as its value is user data.
+var mappingScalarKeys = map[string]bool{
+	"dynamic":                      true,
+	"date_detection":               true,
+	"numeric_detection":            true,
+	"subobjects":                   true,
+	"enabled":                      true,
+	"required":                     true,
+	"index":                        true,
+	"store":                        true,
+	"boost":                        true,
+	"doc_values":                   true,
+	"coerce":                       true,
+	"ignore_malformed":             true,
+	"ignore_above":                 true,
+	"ignore_z_value":               true,
+	"eager_global_ordinals":        true,
+	"norms":                        true,
+	"fielddata":                    true,
+	"index_phrases":                true,
+	"position_increment_gap":       true,
+	"split_queries_on_whitespace":  true,
+	"include_in_parent":            true,
+	"include_in_root":              true,
+	"scaling_factor":               true,
+	"dims":                         true,
+	"max_input_length":             true,
+	"max_shingle_size":             true,
+	"preserve_separators":          true,
+	"preserve_position_increments": true,
+	"positive_score_impact":        true,
+	"enable_position_increments":   true,
+	"depth_limit":                  true,
+	"time_series_dimension":        true,
+	"min_chars":                    true,
+	"max_chars":                    true,
+}
+
+// analysisScalarKeys parameters of the analysis components holding a number or a boolean.
+// Word lists, patterns and rules are not part of them, as their values are user data.
+var analysisScalarKeys = map[string]bool{
+	"max_token_length":               true,
+	"min_gram":                       true,
+	"max_gram":                       true,
+	"min_shingle_size":               true,
+	"max_shingle_size":               true,
+	"output_unigrams":                true,
+	"output_unigrams_if_no_shingles": true,
+	"max_token_count":                true,
+	"consume_all_tokens":             true,
+	"preserve_original":              true,
+	"ignore_case":                    true,
+	"lenient":                        true,
+	"expand":                         true,
+	"updateable":                     true,
+	"length":                         true,
+	"min":                            true,
+	"max":                            true,
+	"hash_count":                     true,
+	"bucket_count":                   true,
+	"hash_set_size":                  true,
+	"with_rotation":                  true,
+	"max_output_size":                true,
+	"buffer_size":                    true,
+	"reverse":                        true,
+	"skip":                           true,
+	"only_on_same_position":          true,
+	"generate_word_parts":            true,
+	"generate_number_parts":          true,
+	"catenate_words":                 true,
+	"catenate_numbers":               true,
+	"catenate_all":                   true,
+	"split_on_case_change":           true,
+	"split_on_numerics":              true,
+	"stem_english_possessive":        true,
+	"adjust_offsets":                 true,
+	"ignore_keywords":                true,
+	"min_word_size":                  true,
+	"min_subword_size":               true,
+	"max_subword_size":               true,
+	"only_longest_match":             true,
+	"dedup":                          true,
+	"longest_only":                   true,
+	"keep_both":                      true,
+	"max_code_len":                   true,
+	"replace":                        true,
+	"discard_punctuation":            true,
+	"discard_compound_token":         true,
+}
+
+// settingListKeys flattened index settings accepting either a single value or a list of values.
+var settingListKeys = map[string]bool{
+	"routing_path":        true,
+	"query.default_field": true,
+	"sort.field":          true,
+	"sort.order":          true,
+	"sort.mode":           true,
+	"sort.missing":        true,
+}
+
+// mappingListKeys mapping parameters of the mappings, meta fields and fields accepting either a
+// single value or a list of values.
+var mappingListKeys = map[string]bool{
+	"copy_to":  true,
+	"includes": true,
+	"excludes": true,
+}
+
+// analysisListKeys parameters of the analysis components accepting either a single value or a
+// list of values. Word lists are not part of them, as Elasticsearch may split single values.
+var analysisListKeys = map[string]bool{
+	"filter":      true,
+	"char_filter": true,
+	"token_chars": true,
+}
+
+// canonicalContext kind of JSON object being canonicalized.
+type canonicalContext int
+
+const (
+	canonicalGeneric canonicalContext = iota
+	canonicalSettings
+	canonicalMappings
+	canonicalProperties
+	canonicalField
+	canonicalMetaField
+	canonicalAnalysis
+	// canonicalScalar value of a known numeric or boolean setting or parameter, whose strings
+	// are normalized into numbers and booleans.
+	canonicalScalar
+)
+
+// scalarKeys returns the keys holding a number or a boolean in objects of the context.
+func (ctx canonicalContext) scalarKeys() map[string]bool {
+	switch ctx {
+	case canonicalSettings:
+		return settingScalarKeys
+	case canonicalMappings, canonicalField, canonicalMetaField:
+		return mappingScalarKeys
+	case canonicalAnalysis:
+		return analysisScalarKeys
+	}
+	return nil
+}
+
+// listKeys returns the keys accepting either a single value or a list of values in objects of
+// the context.
+func (ctx canonicalContext) listKeys() map[string]bool {
+	switch ctx {
+	case canonicalSettings:
+		return settingListKeys
+	case canonicalMappings, canonicalField, canonicalMetaField:
+		return mappingListKeys
+	case canonicalAnalysis:
+		return analysisListKeys
+	}
+	return nil
+}
+
+// Fingerprint returns the hex encoded SHA-256 hash of the canonical form of the Index, which
+// only changes when the index settings, analysis or mappings effectively change. The canonical
+// form is the JSON source with sorted keys, nested settings flattened into dotted keys, default
+// values of settings and field mappings removed, and equivalent spellings normalized, eg ["a"]
+// and "a" for list parameters such as `copy_to` and `filter`, or "true" and true and "1" and 1
+// for numeric and boolean settings and mapping parameters. Free-form values, such as word lists, `null_value` and `_meta`, are kept as is.
+// Fingerprints stamped into the `_meta` field are ignored.
+func (i *Index) Fingerprint() (string, error) {
+	src, err := i.Source(false)
+	if err != nil {
+		return "", err
+	}
+	return fingerprint(src, canonicalSettings)
+}
+
+// Fingerprint returns the hex encoded SHA-256 hash of the canonical form of the Mappings.
+// See Index.Fingerprint for details on the canonical form.
+func (m *Mappings) Fingerprint() (string, error) {
+	src, err := m.Source(false)
+	if err != nil {
+		return "", err
+	}
+	return fingerprint(src, canonicalMappings)
+}
+
+// Fingerprint returns the hex encoded SHA-256 hash of the canonical form of the Analysis.
+// See Index.Fingerprint for details on the canonical form.
+func (a *Analysis) Fingerprint() (string, error) {
+	src, err := a.Source(false)
+	if err != nil {
+		return "", err
+	}
+	return fingerprint(src, canonicalAnalysis)
+}
+
+// StampFingerprint stamps the fingerprint of the Index and the version into the `_meta` field
+// of its mappings, under the "fingerprint" and "fingerprint_version" keys, so the template
+// deployed on the cluster can be compared against the one the code would produce. Other meta
+// data is preserved.
+func (i *Index) StampFingerprint(version string) error {
+	if i.mappings == nil {
+		i.mappings = NewMappings()
+	}
+	hash, err := i.Fingerprint()
+	if err != nil {
+		return err
+	}
+	return i.mappings.stampFingerprint(hash, version)
+}
+
+// StampFingerprint stamps the fingerprint of the Mappings and the version into its `_meta`
+// field, under the "fingerprint" and "fingerprint_version" keys. Other meta data is preserved.
+func (m *Mappings) StampFingerprint(version string) error {
+	hash, err := m.Fingerprint()
+	if err != nil {
+		return err
+	}
+	return m.stampFingerprint(hash, version)
+}
+
+// stampFingerprint merges the fingerprint and version into the `_meta` field.
+func (m *Mappings) stampFingerprint(hash, version string) error {
+	meta := make(map[string]interface{})
+	if m.meta != nil {
+		src, err := m.meta.Source(false)
+		if err != nil {
+			return err
+		}
+		meta = src.(map[string]interface{})
+	}
+	meta[FingerprintMetaKey] = hash
+	meta[FingerprintVersionMetaKey] = version
+	m.meta = NewMetaFieldMeta().Value(meta)
+	return nil
+}
+
+// fingerprint returns the hex encoded SHA-256 hash of the canonical JSON form of src.
+func fingerprint(src interface{}, ctx canonicalContext) (string, error) {
+	data, err := canonicalJSON(src, ctx)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// canonicalJSON returns the canonical JSON form of src. Keys are sorted by encoding/json.
+func canonicalJSON(src interface{}, ctx canonicalContext) ([]byte, error) {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(canonicalize(v, ctx))
+}
+
+// canonicalize normalizes a decoded JSON value.
+func canonicalize(v interface{}, ctx canonicalContext) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		return canonicalizeObject(t, ctx)
+	case []interface{}:
+		values := make([]interface{}, 0, len(t))
+		for _, item := range t {
+			values = append(values, canonicalize(item, ctx))
+		}
+		return values
+	case string:
+		if ctx != canonicalScalar {
+			return t
+		}
+		switch t {
+		case "true":
+			return true
+		case "false":
+			return false
+		}
+		if n, ok := canonicalNumber(t); ok {
+			return n
+		}
+		return t
+	case json.Number:
+		if n, ok := canonicalNumber(t.String()); ok {
+			return n
+		}
+	}
+	return v
+}
+
+// canonicalNumber returns the canonical form of a number written as a string.
+func canonicalNumber(s string) (json.Number, bool) {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return json.Number(strconv.FormatInt(i, 10)), true
+	}
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return json.Number(strconv.FormatUint(u, 10)), true
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return json.Number(strconv.FormatFloat(f, 'g', -1, 64)), true
+	}
+	return "", false
+}
+
+// canonicalizeObject normalizes a decoded JSON object according to its context.
+func canonicalizeObject(object map[string]interface{}, ctx canonicalContext) interface{} {
+	if ctx == canonicalSettings {
+		flattened := make(map[string]interface{})
+		flattenSettings("", object, flattened)
+		object = flattened
+	}
+
+	var defaults []map[string]interface{}
+	switch ctx {
+	case canonicalSettings:
+		defaults = append(defaults, settingDefaults)
+	case canonicalMappings:
+		defaults = append(defaults, mappingsDefaults)
+	case canonicalField:
+		typ, _ := object["type"].(string)
+		if typ == "" {
+			typ = "object"
+		}
+		// datatype specific defaults take precedence over shared defaults.
+		defaults = append(defaults, datatypeDefaults[typ], fieldDefaults)
+	}
+
+	canonical := make(map[string]interface{})
+	for k, v := range object {
+		_, isObject := v.(map[string]interface{})
+		switch {
+		case ctx.scalarKeys()[k] && !isObject:
+			v = canonicalize(v, canonicalScalar)
+		case ctx == canonicalSettings && k == "analysis":
+			v = canonicalize(v, canonicalAnalysis)
+		case ctx == canonicalSettings && k == "mappings":
+			v = canonicalize(v, canonicalMappings)
+		case ctx == canonicalProperties:
+			v = canonicalize(v, canonicalField)
+		case (ctx == canonicalMappings || ctx == canonicalField) && (k == "properties" || k == "fields"):
+			v = canonicalize(v, canonicalProperties)
+		case ctx == canonicalMappings && k == "dynamic_templates":
+			v = canonicalizeDynamicTemplates(v)
+		case ctx == canonicalMappings && k == "_meta":
+			if meta, ok := v.(map[string]interface{}); ok {
+				delete(meta, FingerprintMetaKey)
+				delete(meta, FingerprintVersionMetaKey)
+			}
+			v = canonicalize(v, canonicalGeneric)
+		case ctx == canonicalMappings && strings.HasPrefix(k, "_"):
+			v = canonicalize(v, canonicalMetaField)
+		case ctx == canonicalAnalysis:
+			v = canonicalize(v, canonicalAnalysis)
+		default:
+			v = canonicalize(v, canonicalGeneric)
+		}
+		// single values of list parameters are equivalent to lists of one value.
+		if values, ok := v.([]interface{}); ok && len(values) == 1 && ctx.listKeys()[k] {
+			v = values[0]
+		}
+		if isDefaultValue(k, v, defaults...) {
+			continue
+		}
+		// empty objects and arrays are equivalent to missing ones, except for fields.
+		if ctx != canonicalProperties && isEmptyValue(v) {
+			continue
+		}
+		canonical[k] = v
+	}
+	return canonical
+}
+
+// canonicalizeDynamicTemplates normalizes dynamic templates, whose mapping is a field mapping.
+func canonicalizeDynamicTemplates(v interface{}) interface{} {
+	templates, ok := v.([]interface{})
+	if !ok {
+		templates = []interface{}{v}
+	}
+	canonical := make([]interface{}, 0, len(templates))
+	for _, template := range templates {
+		named, ok := template.(map[string]interface{})
+		if !ok {
+			canonical = append(canonical, canonicalize(template, canonicalGeneric))
+			continue
+		}
+		c := make(map[string]interface{})
+		for name, options := range named {
+			o, ok := options.(map[string]interface{})
+			if !ok {
+				c[name] = canonicalize(options, canonicalGeneric)
+				continue
+			}
+			co := make(map[string]interface{})
+			for k, v := range o {
+				if k == "mapping" {
+					co[k] = canonicalize(v, canonicalField)
+				} else {
+					co[k] = canonicalize(v, canonicalGeneric)
+				}
+			}
+			c[name] = co
+		}
+		canonical = append(canonical, c)
+	}
+	// dynamic templates are ordered, they are kept as an array even with a single template.
+	return canonical
+}
+
+// flattenSettings flattens nested settings into dotted keys, except for the analysis and
+// mappings which keep their structure.
+func flattenSettings(prefix string, settings map[string]interface{}, flattened map[string]interface{}) {
+	for k, v := range settings {
+		key := prefix + k
+		if nested, ok := v.(map[string]interface{}); ok && key != "analysis" && key != "mappings" {
+			flattenSettings(key+".", nested, flattened)
+			continue
+		}
+		flattened[key] = v
+	}
+}
+
+// isDefaultValue returns whether the canonical value of key is one of the defaults.
+func isDefaultValue(key string, v interface{}, defaults ...map[string]interface{}) bool {
+	for _, d := range defaults {
+		def, exists := d[key]
+		if !exists {
+			continue
+		}
+		return reflect.DeepEqual(v, canonicalize(canonicalDefault(def), canonicalGeneric))
+	}
+	return false
+}
+
+// canonicalDefault converts the numbers of a default value into json.Number, as produced by
+// decoding.
+func canonicalDefault(v interface{}) interface{} {
+	switch t := v.(type) {
+	case int:
+		return json.Number(strconv.Itoa(t))
+	case map[string]interface{}:
+		m := make(map[string]interface{})
+		for k, v := range t {
+			m[k] = canonicalDefault(v)
+		}
+		return m
+	}
+	return v
+}
+
+// isEmptyValue returns whether v is an empty object or array.
+func isEmptyValue(v interface{}) bool {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		return len(t) == 0
+	case []interface{}:
+		return len(t) == 0
+	}
+	return false
+}
@@ -0,0 +1,247 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIndexFingerprint(t *testing.T) {
+	tests := []struct {
+		desc  string
+		a     *Index
+		b     *Index
+		equal bool
+	}{
+		// #0
+		{
+			desc:  "Default settings.",
+			a:     NewIndex(),
+			b:     NewIndex().NumberOfReplicas(1).RefreshInterval("1s").AutoExpandReplicas("false"),
+			equal: true,
+		},
+		// #1
+		{
+			desc:  "Different settings.",
+			a:     NewIndex().NumberOfReplicas(1),
+			b:     NewIndex().NumberOfReplicas(2),
+			equal: false,
+		},
+		// #2
+		{
+			desc: "Default field parameters.",
+			a: NewIndex().Mappings(NewMappings().Properties(
+				NewDatatypeText("title").Fields(NewDatatypeKeyword("raw")),
+			)),
+			b: NewIndex().Mappings(NewMappings().DateDetection(true).Properties(
+				NewDatatypeText("title").Index(true).Norms(true).Store(false).
+					Fields(NewDatatypeKeyword("raw").DocValues(true)),
+			)),
+			equal: true,
+		},
+		// #3
+		{
+			desc: "Different field parameters.",
+			a: NewIndex().Mappings(NewMappings().Properties(
+				NewDatatypeText("title"),
+			)),
+			b: NewIndex().Mappings(NewMappings().Properties(
+				NewDatatypeText("title").Store(true),
+			)),
+			equal: false,
+		},
+		// #4
+		{
+			desc: "Equivalent meta spellings.",
+			a: NewIndex().Mappings(NewMappings().Meta(
+				NewMetaFieldMeta().RawJSON(`{"owner":"search","replicated":true,"tags":["a"]}`),
+			)),
+			b: NewIndex().Mappings(NewMappings().Meta(
+				NewMetaFieldMeta().RawJSON(`{"tags":["a"],"replicated":true,"owner":"search"}`),
+			)),
+			equal: true,
+		},
+		// #5
+		{
+			desc:  "Numeric strings in stop words.",
+			a:     NewIndex().Analysis(NewAnalysis().Filter(NewTokenFilterStop("stop").Stopwords("01"))),
+			b:     NewIndex().Analysis(NewAnalysis().Filter(NewTokenFilterStop("stop").Stopwords("1"))),
+			equal: false,
+		},
+		// #6
+		{
+			desc: "Numeric strings in meta.",
+			a: NewIndex().Mappings(NewMappings().Meta(
+				NewMetaFieldMeta().RawJSON(`{"version":"1.10"}`),
+			)),
+			b: NewIndex().Mappings(NewMappings().Meta(
+				NewMetaFieldMeta().RawJSON(`{"version":"1.1"}`),
+			)),
+			equal: false,
+		},
+		// #7
+		{
+			desc:  "Numeric strings in keyword null value.",
+			a:     NewIndex().Mappings(NewMappings().Properties(NewDatatypeKeyword("code").NullValue("007"))),
+			b:     NewIndex().Mappings(NewMappings().Properties(NewDatatypeKeyword("code").NullValue("7"))),
+			equal: false,
+		},
+		// #8
+		{
+			desc:  "Boolean strings in meta.",
+			a:     NewIndex().Mappings(NewMappings().Meta(NewMetaFieldMeta().RawJSON(`{"replicated":"true"}`))),
+			b:     NewIndex().Mappings(NewMappings().Meta(NewMetaFieldMeta().RawJSON(`{"replicated":true}`))),
+			equal: false,
+		},
+		// #9
+		{
+			desc: "Single value and list of one value in meta.",
+			a: NewIndex().Mappings(NewMappings().Meta(
+				NewMetaFieldMeta().RawJSON(`{"tags":["a"]}`),
+			)),
+			b: NewIndex().Mappings(NewMappings().Meta(
+				NewMetaFieldMeta().RawJSON(`{"tags":"a"}`),
+			)),
+			equal: false,
+		},
+	}
+	for i, test := range tests {
+		a, err := test.a.Fingerprint()
+		if err != nil {
+			t.Fatalf("#%d: expected no error, got: %v", i, err)
+		}
+		b, err := test.b.Fingerprint()
+		if err != nil {
+			t.Fatalf("#%d: expected no error, got: %v", i, err)
+		}
+		if equal := a == b; equal != test.equal {
+			t.Errorf("#%d: expected fingerprints equal to be %v, got: %v (%s, %s)", i, test.equal, equal, a, b)
+		}
+	}
+}
+
+func TestAnalysisFingerprint(t *testing.T) {
+	a := NewAnalysis().Filter(
+		NewTokenFilterStop("stop").Stopwords("_english_"),
+		NewTokenFilterLowercase("lowercase"),
+	)
+	b := NewAnalysis().Filter(
+		NewTokenFilterLowercase("lowercase"),
+		NewTokenFilterStop("stop").Stopwords("_english_"),
+	)
+	fa, err := a.Fingerprint()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	fb, err := b.Fingerprint()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if fa != fb {
+		t.Errorf("expected equal fingerprints, got: %s and %s", fa, fb)
+	}
+}
+
+func TestCanonicalJSON(t *testing.T) {
+	src := map[string]interface{}{
+		"number_of_replicas": "1",
+		"max_result_window":  "20000",
+		"refresh_interval":   "5s",
+		"blocks":             map[string]interface{}{"read": "true", "write": false},
+	}
+	data, err := canonicalJSON(src, canonicalSettings)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	expected := `{"blocks.read":true,"max_result_window":20000,"refresh_interval":"5s"}`
+	if string(data) != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, string(data))
+	}
+
+	field := map[string]interface{}{
+		"type":         "keyword",
+		"ignore_above": "256",
+		"index":        "true",
+		"null_value":   "007",
+	}
+	data, err = canonicalJSON(field, canonicalField)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	expected = `{"ignore_above":256,"null_value":"007","type":"keyword"}`
+	if string(data) != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, string(data))
+	}
+
+	field = map[string]interface{}{
+		"type":    "text",
+		"copy_to": []interface{}{"all"},
+		"meta":    map[string]interface{}{"tags": []interface{}{"a"}},
+	}
+	data, err = canonicalJSON(field, canonicalField)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	expected = `{"copy_to":"all","meta":{"tags":["a"]},"type":"text"}`
+	if string(data) != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, string(data))
+	}
+
+	analysis := map[string]interface{}{
+		"analyzer": map[string]interface{}{
+			"my_analyzer": map[string]interface{}{"tokenizer": "standard", "filter": []interface{}{"lowercase"}},
+		},
+		"filter": map[string]interface{}{
+			"my_stop": map[string]interface{}{"type": "stop", "stopwords": []interface{}{"a"}},
+		},
+	}
+	data, err = canonicalJSON(analysis, canonicalAnalysis)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	expected = `{"analyzer":{"my_analyzer":{"filter":"lowercase","tokenizer":"standard"}},"filter":{"my_stop":{"stopwords":["a"],"type":"stop"}}}`
+	if string(data) != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, string(data))
+	}
+}
+
+func TestIndexStampFingerprint(t *testing.T) {
+	i := NewIndex().NumberOfShards(3).Mappings(NewMappings().Meta(
+		NewMetaFieldMeta().Value(map[string]interface{}{"owner": "search"}),
+	))
+	expected, err := i.Fingerprint()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if err := i.StampFingerprint("v1"); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	got, err := i.Fingerprint()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if got != expected {
+		t.Errorf("expected fingerprint to be unchanged by stamping, expected %s, got: %s", expected, got)
+	}
+
+	src, err := i.mappings.meta.Source(false)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	serialized, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	meta := `{"fingerprint":"` + expected + `","fingerprint_version":"v1","owner":"search"}`
+	if string(serialized) != meta {
+		t.Errorf("expected\n%s\n,got:\n%s", meta, string(serialized))
+	}
+
+	// stamping an index without mappings creates them.
+	if err := NewIndex().StampFingerprint("v1"); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
@@ -0,0 +1,464 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// hunspellAffix prefix or suffix rule of a hunspell affix file.
+type hunspellAffix struct {
+	flag      string
+	cross     bool
+	strip     string
+	add       string
+	condition *regexp.Regexp
+}
+
+// previewHunspellEncodings encodings of the hunspell files which can be decoded to preview stems.
+var previewHunspellEncodings = map[string]bool{
+	"UTF-8":      true,
+	"ISO8859-1":  true,
+	"ISO-8859-1": true,
+}
+
+// HunspellDictionary hunspell dictionary of a locale, made of one affix file and one or more
+// dictionary files, as laid out in the `config/hunspell/<locale>` directory of Elasticsearch.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-hunspell-tokenfilter.html
+// for details.
+type HunspellDictionary struct {
+	// Locale locale of the dictionary, ie the name of its directory.
+	Locale string
+	// AffixFile path of the affix file.
+	AffixFile string
+	// DictionaryFiles paths of the dictionary files.
+	DictionaryFiles []string
+
+	encoding string
+	flagMode string
+	prefixes []*hunspellAffix
+	suffixes []*hunspellAffix
+	words    map[string][]map[string]bool
+}
+
+// LoadHunspellDictionaries loads all hunspell dictionaries located in the `hunspell` directory
+// of the Elasticsearch `config` directory, keyed by locale. Every locale directory must contain
+// exactly one `.aff` file and at least one `.dic` file, other files of the `hunspell` directory
+// are ignored as done by Elasticsearch. Dictionaries which are missing files,
+// have conflicting affix files or fail to parse are reported in the returned error, while the
+// valid dictionaries are still returned.
+func LoadHunspellDictionaries(configDir string) (map[string]*HunspellDictionary, error) {
+	hunspellDir := filepath.Join(configDir, "hunspell")
+	entries, err := ioutil.ReadDir(hunspellDir)
+	if err != nil {
+		return nil, err
+	}
+	dictionaries := make(map[string]*HunspellDictionary)
+	var invalid []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		d, err := LoadHunspellDictionary(filepath.Join(hunspellDir, entry.Name()))
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %v", entry.Name(), err))
+			continue
+		}
+		dictionaries[d.Locale] = d
+	}
+	if len(invalid) > 0 {
+		return dictionaries, fmt.Errorf("invalid hunspell dictionaries: %v", invalid)
+	}
+	return dictionaries, nil
+}
+
+// LoadHunspellDictionary loads the hunspell dictionary of a locale directory, which must
+// contain exactly one `.aff` file and at least one `.dic` file. The words and affix rules of
+// dictionaries in an encoding other than UTF-8 or ISO8859-1 are not loaded, see CanPreview.
+func LoadHunspellDictionary(dir string) (*HunspellDictionary, error) {
+	affixFiles, err := filepath.Glob(filepath.Join(dir, "*.aff"))
+	if err != nil {
+		return nil, err
+	}
+	dictionaryFiles, err := filepath.Glob(filepath.Join(dir, "*.dic"))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(affixFiles) == 0:
+		return nil, fmt.Errorf("missing affix (.aff) file")
+	case len(affixFiles) > 1:
+		return nil, fmt.Errorf("conflicting affix (.aff) files: %v", baseNames(affixFiles))
+	case len(dictionaryFiles) == 0:
+		return nil, fmt.Errorf("missing dictionary (.dic) file")
+	}
+	sort.Strings(dictionaryFiles)
+
+	d := &HunspellDictionary{
+		Locale:          filepath.Base(dir),
+		AffixFile:       affixFiles[0],
+		DictionaryFiles: dictionaryFiles,
+		encoding:        "ISO8859-1",
+		words:           make(map[string][]map[string]bool),
+	}
+	if err := d.loadAffixFile(affixFiles[0]); err != nil {
+		return nil, fmt.Errorf("%s: %v", filepath.Base(affixFiles[0]), err)
+	}
+	if !d.CanPreview() {
+		return d, nil
+	}
+	for _, dictionaryFile := range dictionaryFiles {
+		if err := d.loadDictionaryFile(dictionaryFile); err != nil {
+			return nil, fmt.Errorf("%s: %v", filepath.Base(dictionaryFile), err)
+		}
+	}
+	return d, nil
+}
+
+// CanPreview returns whether the words and affix rules of the dictionary are loaded, which
+// requires the UTF-8 or ISO8859-1 encoding. Elasticsearch accepts any encoding supported by
+// Java, such dictionaries are valid but cannot be previewed.
+func (d *HunspellDictionary) CanPreview() bool {
+	return previewHunspellEncodings[d.encoding]
+}
+
+// baseNames returns the base names of the paths.
+func baseNames(paths []string) []string {
+	names := make([]string, 0, len(paths))
+	for _, p := range paths {
+		names = append(names, filepath.Base(p))
+	}
+	return names
+}
+
+// readHunspellLines reads the lines of a hunspell file, decoded from its encoding.
+func (d *HunspellDictionary) readHunspellLines(filename string) ([]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if d.encoding == "ISO8859-1" || d.encoding == "ISO-8859-1" {
+			runes := make([]rune, 0, len(line))
+			for i := 0; i < len(line); i++ {
+				runes = append(runes, rune(line[i]))
+			}
+			line = string(runes)
+		}
+		lines = append(lines, strings.TrimRight(line, "\r"))
+	}
+	return lines, scanner.Err()
+}
+
+// loadAffixFile parses the SET, FLAG, PFX and SFX directives of an affix file.
+func (d *HunspellDictionary) loadAffixFile(filename string) error {
+	// the encoding must be known before decoding, read the SET directive first.
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if fields := strings.Fields(line); len(fields) > 1 && fields[0] == "SET" {
+			d.encoding = strings.ToUpper(fields[1])
+			break
+		}
+	}
+	if !d.CanPreview() {
+		return nil
+	}
+
+	lines, err := d.readHunspellLines(filename)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(lines); i++ {
+		fields := strings.Fields(lines[i])
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		switch fields[0] {
+		case "FLAG":
+			if len(fields) < 2 {
+				return fmt.Errorf("line %d: missing FLAG value", i+1)
+			}
+			d.flagMode = fields[1]
+		case "PFX", "SFX":
+			if len(fields) < 4 {
+				return fmt.Errorf("line %d: malformed %s header", i+1, fields[0])
+			}
+			count, err := strconv.Atoi(fields[3])
+			if err != nil {
+				return fmt.Errorf("line %d: malformed %s rule count", i+1, fields[0])
+			}
+			for j := 0; j < count; j++ {
+				i++
+				if i >= len(lines) {
+					return fmt.Errorf("%s %s: expected %d rules, got %d", fields[0], fields[1], count, j)
+				}
+				rule := strings.Fields(lines[i])
+				if len(rule) < 4 || rule[0] != fields[0] || rule[1] != fields[1] {
+					return fmt.Errorf("line %d: malformed %s rule", i+1, fields[0])
+				}
+				affix, err := newHunspellAffix(fields[0] == "PFX", fields[1], fields[2] == "Y", rule)
+				if err != nil {
+					return fmt.Errorf("line %d: %v", i+1, err)
+				}
+				if fields[0] == "PFX" {
+					d.prefixes = append(d.prefixes, affix)
+				} else {
+					d.suffixes = append(d.suffixes, affix)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// newHunspellAffix parses a prefix or suffix rule, eg "SFX A y ies [^aeiou]y".
+func newHunspellAffix(prefix bool, flag string, cross bool, rule []string) (*hunspellAffix, error) {
+	affix := &hunspellAffix{
+		flag:  flag,
+		cross: cross,
+		strip: rule[2],
+		add:   rule[3],
+	}
+	if affix.strip == "0" {
+		affix.strip = ""
+	}
+	// continuation flags are not supported, only the affix itself is kept.
+	if i := strings.Index(affix.add, "/"); i >= 0 {
+		affix.add = affix.add[:i]
+	}
+	if affix.add == "0" {
+		affix.add = ""
+	}
+	condition := "."
+	if len(rule) > 4 {
+		condition = rule[4]
+	}
+	pattern := condition + "$"
+	if prefix {
+		pattern = "^" + condition
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition %q", condition)
+	}
+	affix.condition = re
+	return affix, nil
+}
+
+// loadDictionaryFile parses the words and flags of a dictionary file.
+func (d *HunspellDictionary) loadDictionaryFile(filename string) error {
+	lines, err := d.readHunspellLines(filename)
+	if err != nil {
+		return err
+	}
+	for i, line := range lines {
+		// the first line is the approximate number of words.
+		if i == 0 {
+			if _, err := strconv.Atoi(strings.TrimSpace(line)); err == nil {
+				continue
+			}
+		}
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// strip morphological fields.
+		if j := strings.IndexAny(line, " \t"); j >= 0 {
+			line = line[:j]
+		}
+		word, flags := line, ""
+		if j := strings.Index(line, "/"); j >= 0 {
+			word, flags = line[:j], line[j+1:]
+		}
+		set := make(map[string]bool)
+		for _, flag := range d.parseFlags(flags) {
+			set[flag] = true
+		}
+		d.words[word] = append(d.words[word], set)
+	}
+	return nil
+}
+
+// parseFlags splits flags according to the FLAG mode of the affix file.
+func (d *HunspellDictionary) parseFlags(flags string) []string {
+	var parsed []string
+	switch d.flagMode {
+	case "long":
+		runes := []rune(flags)
+		for i := 0; i+1 < len(runes); i += 2 {
+			parsed = append(parsed, string(runes[i:i+2]))
+		}
+	case "num":
+		for _, flag := range strings.Split(flags, ",") {
+			if flag = strings.TrimSpace(flag); flag != "" {
+				parsed = append(parsed, flag)
+			}
+		}
+	default:
+		for _, r := range flags {
+			parsed = append(parsed, string(r))
+		}
+	}
+	return parsed
+}
+
+// hasFlag returns whether the word is in the dictionary with the flag.
+func (d *HunspellDictionary) hasFlag(word, flag string) bool {
+	for _, flags := range d.words[word] {
+		if flags[flag] {
+			return true
+		}
+	}
+	return false
+}
+
+// Stem returns the stems of the word, applying a single level of prefix and suffix stripping
+// the same way as the `hunspell` token filter. Stems are returned in the order they are found.
+// When dedup is true, duplicated stems are removed. When longestOnly is true, only the longest
+// stem is returned.
+func (d *HunspellDictionary) Stem(word string, dedup, longestOnly bool) []string {
+	var stems []string
+	if entries, exists := d.words[word]; exists {
+		for range entries {
+			stems = append(stems, word)
+		}
+	}
+	for _, sfx := range d.suffixes {
+		if !strings.HasSuffix(word, sfx.add) {
+			continue
+		}
+		candidate := strings.TrimSuffix(word, sfx.add) + sfx.strip
+		if candidate == "" || !sfx.condition.MatchString(candidate) {
+			continue
+		}
+		if d.hasFlag(candidate, sfx.flag) {
+			stems = append(stems, candidate)
+		}
+		if !sfx.cross {
+			continue
+		}
+		// cross product, the prefix is stripped from the suffix stripped candidate.
+		for _, pfx := range d.prefixes {
+			if !pfx.cross || !strings.HasPrefix(candidate, pfx.add) {
+				continue
+			}
+			stem := pfx.strip + strings.TrimPrefix(candidate, pfx.add)
+			if stem != "" && pfx.condition.MatchString(stem) && d.hasFlag(stem, pfx.flag) && d.hasFlag(stem, sfx.flag) {
+				stems = append(stems, stem)
+			}
+		}
+	}
+	for _, pfx := range d.prefixes {
+		if !strings.HasPrefix(word, pfx.add) {
+			continue
+		}
+		candidate := pfx.strip + strings.TrimPrefix(word, pfx.add)
+		if candidate != "" && pfx.condition.MatchString(candidate) && d.hasFlag(candidate, pfx.flag) {
+			stems = append(stems, candidate)
+		}
+	}
+
+	if dedup {
+		seen := make(map[string]bool)
+		var deduped []string
+		for _, stem := range stems {
+			if !seen[stem] {
+				seen[stem] = true
+				deduped = append(deduped, stem)
+			}
+		}
+		stems = deduped
+	}
+	if longestOnly && len(stems) > 1 {
+		longest := stems[0]
+		for _, stem := range stems[1:] {
+			if len([]rune(stem)) > len([]rune(longest)) {
+				longest = stem
+			}
+		}
+		stems = []string{longest}
+	}
+	return stems
+}
+
+// Preview returns the tokens the hunspell token filter would emit for each of the sample
+// words, using the `dedup`, `longest_only` and `ignore_case` options of the filter. Words
+// without any stem are kept as is, as done by Elasticsearch, which is the case of every word
+// when the dictionary cannot be previewed, see CanPreview.
+func (h *TokenFilterHunspell) Preview(d *HunspellDictionary, words ...string) map[string][]string {
+	dedup := h.dedup == nil || *h.dedup
+	longestOnly := h.longestOnly != nil && *h.longestOnly
+	preview := make(map[string][]string)
+	for _, word := range words {
+		input := word
+		if h.ignoreCase != nil && *h.ignoreCase {
+			input = strings.ToLower(input)
+		}
+		stems := d.Stem(input, dedup, longestOnly)
+		if len(stems) == 0 {
+			stems = []string{word}
+		}
+		preview[word] = stems
+	}
+	return preview
+}
+
+// ValidateHunspellDictionaries validates the hunspell dictionaries located in the Elasticsearch
+// `config` directory, that the locale of every filter has a matching dictionary, and that the
+// dictionary files of every filter, if any, exist in the dictionary of its locale.
+func ValidateHunspellDictionaries(configDir string, filters ...*TokenFilterHunspell) error {
+	dictionaries, err := LoadHunspellDictionaries(configDir)
+	var invalid []string
+	if err != nil {
+		invalid = append(invalid, err.Error())
+	}
+	for _, f := range filters {
+		if f.locale == "" {
+			invalid = append(invalid, fmt.Sprintf("%s: missing locale", f.name))
+			continue
+		}
+		d, exists := dictionaries[f.locale]
+		if !exists {
+			invalid = append(invalid, fmt.Sprintf("%s: missing dictionary for locale %q", f.name, f.locale))
+			continue
+		}
+		if f.dictionary == "" {
+			continue
+		}
+		files := make(map[string]bool)
+		for _, name := range baseNames(d.DictionaryFiles) {
+			files[name] = true
+		}
+		for _, name := range strings.Split(f.dictionary, ",") {
+			name = strings.TrimSpace(name)
+			if !files[name] && !files[name+".dic"] {
+				invalid = append(invalid, fmt.Sprintf("%s: missing dictionary file %q for locale %q, got %v",
+					f.name, name, f.locale, baseNames(d.DictionaryFiles)))
+			}
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("%s", strings.Join(invalid, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testHunspellAffix = `SET UTF-8
+# suffixes
+SFX S Y 2
+SFX S 0 s [^sxy]
+SFX S y ies [^aeiou]y
+SFX D Y 1
+SFX D 0 ed [^e]
+PFX U Y 1
+PFX U 0 un .
+`
+
+// writeHunspellFiles writes files relative to dir, creating intermediate directories.
+func writeHunspellFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestHunspellDictionaryStem(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hunspell")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeHunspellFiles(t, dir, map[string]string{
+		"hunspell/en_US/en_US.aff":  testHunspellAffix,
+		"hunspell/en_US/en_US.dic":  "3\nwalk/SD\nfly/S\nlock/UD\n",
+		"hunspell/en_US/extra.dic":  "2\nwalk/S\nlocked\n",
+		"hunspell/fr_FR/fr_FR.dic":  "1\nmarcher\n",
+		"hunspell/de_DE/de_DE.aff":  testHunspellAffix,
+		"hunspell/de_DE/de_AT.aff":  testHunspellAffix,
+		"hunspell/de_DE/de_DE.dic":  "1\ngehen\n",
+		"hunspell/README":           "dictionaries",
+		"hunspell/nl_NL/nl_NL.aff":  "SET KOI8-R\n",
+		"hunspell/nl_NL/nl_NL.dic":  "1\nlopen\n",
+		"hunspell/it_IT/it_IT.aff":  testHunspellAffix,
+		"hunspell/it_IT/ignore.txt": "",
+	})
+
+	dictionaries, err := LoadHunspellDictionaries(dir)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	for _, expected := range []string{
+		"de_DE: conflicting affix (.aff) files: [de_AT.aff de_DE.aff]",
+		"fr_FR: missing affix (.aff) file",
+		"it_IT: missing dictionary (.dic) file",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to contain %q, got: %v", expected, err)
+		}
+	}
+	if strings.Contains(err.Error(), "README") || strings.Contains(err.Error(), "nl_NL") {
+		t.Errorf("expected README and nl_NL to be valid, got: %v", err)
+	}
+	if len(dictionaries) != 2 || dictionaries["en_US"] == nil || dictionaries["nl_NL"] == nil {
+		t.Fatalf("expected en_US and nl_NL dictionaries only, got: %v", dictionaries)
+	}
+	if nl := dictionaries["nl_NL"]; nl.CanPreview() {
+		t.Error("expected KOI8-R dictionary not to be previewable")
+	}
+	d := dictionaries["en_US"]
+	if !d.CanPreview() {
+		t.Error("expected UTF-8 dictionary to be previewable")
+	}
+	if got, expected := len(d.DictionaryFiles), 2; got != expected {
+		t.Errorf("expected %d dictionary files, got: %d", expected, got)
+	}
+
+	tests := []struct {
+		desc        string
+		word        string
+		dedup       bool
+		longestOnly bool
+		expected    []string
+	}{
+		// #0
+		{
+			desc:     "Suffix.",
+			word:     "walks",
+			dedup:    true,
+			expected: []string{"walk"},
+		},
+		// #1
+		{
+			desc:     "Suffix with strip and condition.",
+			word:     "flies",
+			dedup:    true,
+			expected: []string{"fly"},
+		},
+		// #2
+		{
+			desc:     "Cross product of prefix and suffix.",
+			word:     "unlocked",
+			dedup:    true,
+			expected: []string{"lock"},
+		},
+		// #3
+		{
+			desc:     "Word and stem.",
+			word:     "locked",
+			dedup:    true,
+			expected: []string{"locked", "lock"},
+		},
+		// #4
+		{
+			desc:        "Word and stem with longest only.",
+			word:        "locked",
+			dedup:       true,
+			longestOnly: true,
+			expected:    []string{"locked"},
+		},
+		// #5
+		{
+			desc:     "Duplicated word without dedup.",
+			word:     "walk",
+			expected: []string{"walk", "walk"},
+		},
+		// #6
+		{
+			desc:     "Duplicated word with dedup.",
+			word:     "walk",
+			dedup:    true,
+			expected: []string{"walk"},
+		},
+		// #7
+		{
+			desc:  "Unknown word.",
+			word:  "runs",
+			dedup: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if got := d.Stem(test.word, test.dedup, test.longestOnly); !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected\n%v\n,got:\n%v", test.expected, got)
+			}
+		})
+	}
+
+	preview := NewTokenFilterHunspell("test").Locale("en_US").IgnoreCase(true).LongestOnly(true).Preview(d, "Locked", "Runs")
+	expected := map[string][]string{
+		"Locked": {"locked"},
+		"Runs":   {"Runs"},
+	}
+	if !reflect.DeepEqual(preview, expected) {
+		t.Errorf("expected\n%v\n,got:\n%v", expected, preview)
+	}
+}
+
+func TestValidateHunspellDictionaries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hunspell")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeHunspellFiles(t, dir, map[string]string{
+		"hunspell/en_US/en_US.aff": testHunspellAffix,
+		"hunspell/en_US/en_US.dic": "1\nwalk/S\n",
+		"hunspell/ru_RU/ru_RU.aff": "SET KOI8-R\n",
+		"hunspell/ru_RU/ru_RU.dic": "1\n",
+		"hunspell/README":          "dictionaries",
+	})
+
+	tests := []struct {
+		desc    string
+		filters []*TokenFilterHunspell
+		valid   bool
+	}{
+		// #0
+		{
+			desc:    "Existing locale.",
+			filters: []*TokenFilterHunspell{NewTokenFilterHunspell("test").Locale("en_US")},
+			valid:   true,
+		},
+		// #1
+		{
+			desc:    "Missing locale.",
+			filters: []*TokenFilterHunspell{NewTokenFilterHunspell("test").Locale("fr_FR")},
+			valid:   false,
+		},
+		// #2
+		{
+			desc:    "Missing locale with dictionary.",
+			filters: []*TokenFilterHunspell{NewTokenFilterHunspell("test").Dictionary("en_US")},
+			valid:   false,
+		},
+		// #3
+		{
+			desc:    "Existing dictionary file.",
+			filters: []*TokenFilterHunspell{NewTokenFilterHunspell("test").Locale("en_US").Dictionary("en_US.dic")},
+			valid:   true,
+		},
+		// #4
+		{
+			desc:    "Existing dictionary file without extension.",
+			filters: []*TokenFilterHunspell{NewTokenFilterHunspell("test").Locale("en_US").Dictionary("en_US")},
+			valid:   true,
+		},
+		// #5
+		{
+			desc:    "Missing dictionary file.",
+			filters: []*TokenFilterHunspell{NewTokenFilterHunspell("test").Locale("en_US").Dictionary("en_GB.dic")},
+			valid:   false,
+		},
+		// #6
+		{
+			desc:    "Existing locale in other encoding.",
+			filters: []*TokenFilterHunspell{NewTokenFilterHunspell("test").Locale("ru_RU")},
+			valid:   true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := ValidateHunspellDictionaries(dir, test.filters...)
+			if test.valid && err != nil {
+				t.Errorf("expected valid, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
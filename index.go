@@ -15,6 +15,8 @@ type Index struct {
 	codec                         string
 	routingPartitionSize          *int
 	loadFixedBitsetFiltersEagerly *bool
+	mode                          string
+	routingPath                   []string
 
 	// dynamic settings
 	numberOfReplicas           *int
@@ -60,6 +62,7 @@ type Index struct {
 	mappingNestedFieldsLimit    *int
 	mappingNestedObjectsLimit   *int
 	mappingFieldNameLengthLimit *int
+	mappingDimensionFieldsLimit *int
 
 	// merging
 	mergeSchedulerMaxThreadCount *int
@@ -103,6 +106,11 @@ type Index struct {
 	lifecycleRolloverAlias        string
 	lifecycleParseOriginationDate *bool
 	lifecycleOriginationDate      *int
+
+	// time series
+	timeSeriesStartTime string
+	timeSeriesEndTime   string
+	lookAheadTime       string
 }
 
 // NewIndex initializes a new Index.
@@ -175,6 +183,28 @@ func (i *Index) LoadFixedBitsetFiltersEagerly(loadFixedBitsetFiltersEagerly bool
 	return i
 }
 
+// Mode sets the index mode.
+// Can be set to the following values:
+// "standard" - Regular index.
+// "time_series" - Time series index (TSDB), which routes documents by their dimensions and
+// 								only accepts documents within its time bounds.
+// Defaults to "standard".
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds-index-settings.html
+// for details.
+func (i *Index) Mode(mode string) *Index {
+	i.mode = mode
+	return i
+}
+
+// RoutingPath sets the dimension fields, or wildcard patterns of dimension fields, used to
+// route the documents of a time series index to its shards. Only `keyword` dimension fields
+// can be used. Defaults to the `keyword` dimension fields of the mappings.
+func (i *Index) RoutingPath(routingPath ...string) *Index {
+	i.routingPath = append(i.routingPath, routingPath...)
+	return i
+}
+
 // * <-- Dynamic Settings -->
 // Dynamic settings can be changed on a live index using the update-index-settings API.
 // ! Changing static or dynamic index settings on a closed index could result in incorrect settings that are
@@ -519,6 +549,14 @@ func (i *Index) MappingFieldNameLengthLimit(mappingFieldNameLengthLimit int) *In
 	return i
 }
 
+// MappingDimensionFieldsLimit sets the maximum number of time series dimensions of a time series
+// index.
+// Defaults to 16.
+func (i *Index) MappingDimensionFieldsLimit(mappingDimensionFieldsLimit int) *Index {
+	i.mappingDimensionFieldsLimit = &mappingDimensionFieldsLimit
+	return i
+}
+
 // * <-- Merging Settings -->
 // Merging settings control over how shards are merged by the background merge process.
 //
@@ -812,6 +850,38 @@ func (i *Index) LifecycleOriginationDate(lifecycleOriginationDate int) *Index {
 	return i
 }
 
+// * <-- Time Series Settings -->
+// Time series settings specify the time bounds of time series indices, which only accept
+// documents with a `@timestamp` within them. Set on the backing indices of time series data
+// streams by Elasticsearch.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds-index-settings.html
+// for details.
+
+// TimeSeriesStartTime sets the earliest `@timestamp` accepted by the index, inclusive, eg
+// "2099-01-01T00:00:00Z". Accepts dates in the `strict_date_optional_time` format or epoch
+// milliseconds.
+func (i *Index) TimeSeriesStartTime(timeSeriesStartTime string) *Index {
+	i.timeSeriesStartTime = timeSeriesStartTime
+	return i
+}
+
+// TimeSeriesEndTime sets the latest `@timestamp` accepted by the index, exclusive, eg
+// "2099-01-02T00:00:00Z". Accepts dates in the `strict_date_optional_time` format or epoch
+// milliseconds.
+func (i *Index) TimeSeriesEndTime(timeSeriesEndTime string) *Index {
+	i.timeSeriesEndTime = timeSeriesEndTime
+	return i
+}
+
+// LookAheadTime sets the interval of time the write index of a time series data stream accepts
+// documents in the future, which sets the `index.time_series.end_time` of new backing indices.
+// Defaults to "2h".
+func (i *Index) LookAheadTime(lookAheadTime string) *Index {
+	i.lookAheadTime = lookAheadTime
+	return i
+}
+
 // Source returns the serializable JSON for the source builder.
 func (i *Index) Source(includeName bool) (interface{}, error) {
 	// {
@@ -821,6 +891,8 @@ func (i *Index) Source(includeName bool) (interface{}, error) {
 	// 		"codec": "default",
 	// 		"routing_partition_size": "1",
 	// 		"load_fixed_bitset_filters_eagerly": true,
+	// 		"mode": "time_series",
+	// 		"routing_path": ["host.name"],
 	// 		"number_of_replicas": 1,
 	// 		"auto_expand_replicas": "false",
 	// 		"search.idle.after": "30s",
@@ -935,6 +1007,7 @@ func (i *Index) Source(includeName bool) (interface{}, error) {
 	// 		"mapping.nested_fields.limit": 50,
 	// 		"mapping.nested_objects.limit": 10000,
 	// 		"mapping.field_name_length.limit": 10000,
+	// 		"mapping.dimension_fields.limit": 16,
 	// 		"merge.scheduler.max_thread_count": 1,
 	// 		"similarity": {
 	// 			"my_similarity": {
@@ -969,7 +1042,10 @@ func (i *Index) Source(includeName bool) (interface{}, error) {
 	// 		"lifecycle.name": "lifecycle_name",
 	// 		"lifecycle.rollover_alias": "lifecycle_alias",
 	// 		"lifecycle.parse_origination_date": true,
-	// 		"lifecycle.origination_date": 1579442569
+	// 		"lifecycle.origination_date": 1579442569,
+	// 		"time_series.start_time": "2099-01-01T00:00:00Z",
+	// 		"time_series.end_time": "2099-01-02T00:00:00Z",
+	// 		"look_ahead_time": "2h"
 	// 	}
 	// }
 	options := make(map[string]interface{})
@@ -989,6 +1065,12 @@ func (i *Index) Source(includeName bool) (interface{}, error) {
 	if i.loadFixedBitsetFiltersEagerly != nil {
 		options["load_fixed_bitset_filters_eagerly"] = i.loadFixedBitsetFiltersEagerly
 	}
+	if i.mode != "" {
+		options["mode"] = i.mode
+	}
+	if len(i.routingPath) > 0 {
+		options["routing_path"] = i.routingPath
+	}
 	if i.numberOfReplicas != nil {
 		options["number_of_replicas"] = i.numberOfReplicas
 	}
@@ -1116,6 +1198,9 @@ func (i *Index) Source(includeName bool) (interface{}, error) {
 	if i.mappingFieldNameLengthLimit != nil {
 		options["mapping.field_name_length.limit"] = i.mappingFieldNameLengthLimit
 	}
+	if i.mappingDimensionFieldsLimit != nil {
+		options["mapping.dimension_fields.limit"] = i.mappingDimensionFieldsLimit
+	}
 	if i.mergeSchedulerMaxThreadCount != nil {
 		options["merge.scheduler.max_thread_count"] = i.mergeSchedulerMaxThreadCount
 	}
@@ -1235,6 +1320,15 @@ func (i *Index) Source(includeName bool) (interface{}, error) {
 	if i.lifecycleOriginationDate != nil {
 		options["lifecycle.origination_date"] = i.lifecycleOriginationDate
 	}
+	if i.timeSeriesStartTime != "" {
+		options["time_series.start_time"] = i.timeSeriesStartTime
+	}
+	if i.timeSeriesEndTime != "" {
+		options["time_series.end_time"] = i.timeSeriesEndTime
+	}
+	if i.lookAheadTime != "" {
+		options["look_ahead_time"] = i.lookAheadTime
+	}
 
 	if !includeName {
 		return options, nil
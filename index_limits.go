@@ -0,0 +1,92 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// intOrDefault returns the value pointed by v, or def when v is nil.
+func intOrDefault(v *int, def int) int {
+	if v == nil {
+		return def
+	}
+	return *v
+}
+
+// ValidateLimits cross-checks the analysis components and mappings against the limits of the
+// index, suggesting the minimal setting value whenever a limit needs to be raised:
+// - `max_gram` - `min_gram` of `ngram` tokenizers and token filters against `index.max_ngram_diff`.
+// - `max_shingle_size` - `min_shingle_size` (+1 with `output_unigrams`) of `shingle` token
+// filters against `index.max_shingle_diff`.
+// - `max_token_count` of `limit` token filters against `index.analyze.max_token_count`, as the
+// `_analyze` API fails before the limit is reached otherwise.
+// - maxTextLength, the expected maximum length of text values, against
+// `index.highlight.max_analyzed_offset` for text fields indexed without offsets or term vectors
+// with offsets. Highlighting checks are skipped when maxTextLength is 0.
+func (i *Index) ValidateLimits(maxTextLength int) error {
+	var (
+		invalid                    []string
+		maxNGramDiff               = intOrDefault(i.maxNGramDiff, 1)
+		maxShingleDiff             = intOrDefault(i.maxShingleDiff, 3)
+		analyzeMaxTokenCount       = intOrDefault(i.analyzeMaxTokenCount, 10000)
+		highlightMaxAnalyzedOffset = intOrDefault(i.highlightMaxAnalyzedOffset, 1000000)
+	)
+	ngram := func(component string, minGram, maxGram *int) {
+		if diff := intOrDefault(maxGram, 2) - intOrDefault(minGram, 1); diff > maxNGramDiff {
+			invalid = append(invalid, fmt.Sprintf("%s: max_gram - min_gram is %d, which exceeds "+
+				"index.max_ngram_diff %d, set index.max_ngram_diff to at least %d", component, diff, maxNGramDiff, diff))
+		}
+	}
+
+	if a := i.analysis; a != nil {
+		for _, t := range a.tokenizer {
+			if n, ok := t.(*TokenizerNGram); ok {
+				ngram("tokenizer ["+n.name+"]", n.minGram, n.maxGram)
+			}
+		}
+		for _, f := range a.filter {
+			switch t := f.(type) {
+			case *TokenFilterNGram:
+				ngram("filter ["+t.name+"]", t.minGram, t.maxGram)
+			case *TokenFilterShingle:
+				diff := intOrDefault(t.maxShingleSize, 2) - intOrDefault(t.minShingleSize, 2)
+				if t.outputUnigrams == nil || *t.outputUnigrams {
+					diff++
+				}
+				if diff > maxShingleDiff {
+					invalid = append(invalid, fmt.Sprintf("filter [%s]: max_shingle_size - min_shingle_size "+
+						"(+1 with output_unigrams) is %d, which exceeds index.max_shingle_diff %d, set "+
+						"index.max_shingle_diff to at least %d", t.name, diff, maxShingleDiff, diff))
+				}
+			case *TokenFilterLimitTokenCount:
+				if count := intOrDefault(t.maxTokenCount, 1); count > analyzeMaxTokenCount {
+					invalid = append(invalid, fmt.Sprintf("filter [%s]: max_token_count %d exceeds "+
+						"index.analyze.max_token_count %d, the _analyze API fails before the limit is "+
+						"reached, set index.analyze.max_token_count to at least %d", t.name, count, analyzeMaxTokenCount, count))
+				}
+			}
+		}
+	}
+
+	if m := i.mappings; m != nil && maxTextLength > highlightMaxAnalyzedOffset {
+		walkDatatypes("", m.properties, func(path string, d Datatype) {
+			t, ok := d.(*DatatypeText)
+			if !ok || t.indexOptions == "offsets" || strings.Contains(t.termVector, "offsets") {
+				return
+			}
+			invalid = append(invalid, fmt.Sprintf("field [%s]: text of length %d exceeds "+
+				"index.highlight.max_analyzed_offset %d and is highlighted without offsets, set "+
+				"index.highlight.max_analyzed_offset to at least %d or index the field with offsets",
+				path, maxTextLength, highlightMaxAnalyzedOffset, maxTextLength))
+		})
+	}
+
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid index limits: %v", invalid)
+	}
+	return nil
+}
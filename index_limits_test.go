@@ -0,0 +1,108 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIndexValidateLimits(t *testing.T) {
+	tests := []struct {
+		desc          string
+		i             *Index
+		maxTextLength int
+		expected      []string
+	}{
+		// #0
+		{
+			desc: "Within default limits.",
+			i: NewIndex().Analysis(NewAnalysis().
+				Tokenizer(NewTokenizerNGram("ngram").MinGram(2).MaxGram(3)).
+				Filter(
+					NewTokenFilterShingle("shingle").MaxShingleSize(4),
+					NewTokenFilterLimitTokenCount("limit").MaxTokenCount(100),
+				),
+			),
+		},
+		// #1
+		{
+			desc: "Exceeding ngram and shingle limits.",
+			i: NewIndex().MaxShingleDiff(2).Analysis(NewAnalysis().
+				Tokenizer(NewTokenizerNGram("ngram_tokenizer").MinGram(2).MaxGram(5)).
+				Filter(
+					NewTokenFilterNGram("ngram_filter").MaxGram(4),
+					NewTokenFilterShingle("shingle").MaxShingleSize(4),
+				),
+			),
+			expected: []string{
+				"tokenizer [ngram_tokenizer]: max_gram - min_gram is 3, which exceeds index.max_ngram_diff 1, set index.max_ngram_diff to at least 3",
+				"filter [ngram_filter]: max_gram - min_gram is 3",
+				"filter [shingle]: max_shingle_size - min_shingle_size (+1 with output_unigrams) is 3, which exceeds index.max_shingle_diff 2, set index.max_shingle_diff to at least 3",
+			},
+		},
+		// #2
+		{
+			desc: "Raised limits.",
+			i: NewIndex().MaxNGramDiff(3).MaxShingleDiff(3).Analysis(NewAnalysis().
+				Tokenizer(NewTokenizerNGram("ngram_tokenizer").MinGram(2).MaxGram(5)).
+				Filter(NewTokenFilterShingle("shingle").MaxShingleSize(4)),
+			),
+		},
+		// #3
+		{
+			desc: "Limit token count above analyze max token count.",
+			i: NewIndex().AnalyzeMaxTokenCount(500).Analysis(NewAnalysis().
+				Filter(NewTokenFilterLimitTokenCount("limit").MaxTokenCount(1000)),
+			),
+			expected: []string{"filter [limit]: max_token_count 1000 exceeds index.analyze.max_token_count 500"},
+		},
+		// #4
+		{
+			desc: "Highlighting long texts.",
+			i: NewIndex().HighlightMaxAnalyzedOffset(1000).Mappings(NewMappings().Properties(
+				NewDatatypeText("title"),
+				NewDatatypeText("body").IndexOptions("offsets"),
+				NewDatatypeObject("comment").Properties(
+					NewDatatypeText("text").TermVector("with_positions_offsets"),
+					NewDatatypeText("summary"),
+				),
+			)),
+			maxTextLength: 5000,
+			expected: []string{
+				"field [title]: text of length 5000 exceeds index.highlight.max_analyzed_offset 1000 and is highlighted without offsets, set index.highlight.max_analyzed_offset to at least 5000",
+				"field [comment.summary]",
+			},
+		},
+		// #5
+		{
+			desc:     "Highlighting checks skipped.",
+			i:        NewIndex().HighlightMaxAnalyzedOffset(1000).Mappings(NewMappings().Properties(NewDatatypeText("title"))),
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.i.ValidateLimits(test.maxTextLength)
+			if len(test.expected) == 0 {
+				if err != nil {
+					t.Errorf("expected valid, got: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			for _, expected := range test.expected {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error to contain %q, got: %v", expected, err)
+				}
+			}
+			if got := strings.Count(err.Error(), "at least"); got != len(test.expected) {
+				t.Errorf("expected %d suggestions, got: %v", len(test.expected), err)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// IndexTemplate composable index template, which is applied to indices and data streams
+// matching its index patterns when they are created, used as the body of the put index
+// template API `PUT _index_template/<name>`. Requires Elasticsearch 7.8 or later.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.9/index-templates.html
+// for details.
+type IndexTemplate struct {
+	name          string
+	indexPatterns []string
+	template      *Index
+	lifecycle     *DataStreamLifecycle
+	dataStream    *DataStream
+	composedOf    []string
+	priority      *int
+	version       *int
+	meta          *MetaFieldMeta
+}
+
+// NewIndexTemplate initializes a new IndexTemplate.
+func NewIndexTemplate(name string) *IndexTemplate {
+	return &IndexTemplate{
+		name:          name,
+		indexPatterns: make([]string, 0),
+		composedOf:    make([]string, 0),
+	}
+}
+
+// Name returns field key for the IndexTemplate.
+func (t *IndexTemplate) Name() string {
+	return t.name
+}
+
+// IndexPatterns sets the wildcard expressions matching the names of the indices and data
+// streams the template is applied to.
+func (t *IndexTemplate) IndexPatterns(indexPatterns ...string) *IndexTemplate {
+	t.indexPatterns = append(t.indexPatterns, indexPatterns...)
+	return t
+}
+
+// Template sets the index settings, analysis and mappings applied to matching indices, which
+// are serialized into the `settings` and `mappings` of the template.
+func (t *IndexTemplate) Template(template *Index) *IndexTemplate {
+	t.template = template
+	return t
+}
+
+// Lifecycle sets the built-in lifecycle of the matching data streams. Requires Elasticsearch
+// 8.11 or later.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.11/data-stream-lifecycle.html
+// for details.
+func (t *IndexTemplate) Lifecycle(lifecycle *DataStreamLifecycle) *IndexTemplate {
+	t.lifecycle = lifecycle
+	return t
+}
+
+// DataStream sets whether the template creates data streams instead of regular indices,
+// which requires the `@timestamp` field to be mapped as a `date` or `date_nanos` datatype.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.9/set-up-a-data-stream.html
+// for details.
+func (t *IndexTemplate) DataStream(dataStream *DataStream) *IndexTemplate {
+	t.dataStream = dataStream
+	return t
+}
+
+// ComposedOf sets the names of the component templates merged into the template, in order.
+func (t *IndexTemplate) ComposedOf(composedOf ...string) *IndexTemplate {
+	t.composedOf = append(t.composedOf, composedOf...)
+	return t
+}
+
+// Priority sets the precedence of the template when several templates match a new index,
+// the highest priority being applied. Defaults to 0.
+func (t *IndexTemplate) Priority(priority int) *IndexTemplate {
+	t.priority = &priority
+	return t
+}
+
+// Version sets the version number used to manage the template externally.
+func (t *IndexTemplate) Version(version int) *IndexTemplate {
+	t.version = &version
+	return t
+}
+
+// Meta sets application specific metadata of the template.
+func (t *IndexTemplate) Meta(meta *MetaFieldMeta) *IndexTemplate {
+	t.meta = meta
+	return t
+}
+
+// Validate validates IndexTemplate.
+func (t *IndexTemplate) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && t.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(t.indexPatterns) == 0 {
+		invalid = append(invalid, "IndexPatterns")
+	}
+	if t.priority != nil && *t.priority < 0 {
+		invalid = append(invalid, "Priority")
+	}
+	if t.lifecycle != nil {
+		if err := t.lifecycle.Validate(); err != nil {
+			invalid = append(invalid, "Lifecycle")
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	if t.dataStream != nil && t.template != nil && t.template.mappings != nil {
+		return t.template.mappings.ValidateDataStream()
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (t *IndexTemplate) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"index_patterns": ["logs-*"],
+	// 		"data_stream": {
+	// 			"hidden": false
+	// 		},
+	// 		"template": {
+	// 			"settings": {
+	// 				"number_of_shards": 1
+	// 			},
+	// 			"mappings": {
+	// 				"properties": {
+	// 					"@timestamp": {
+	// 						"type": "date"
+	// 					}
+	// 				}
+	// 			},
+	// 			"lifecycle": {
+	// 				"data_retention": "7d"
+	// 			}
+	// 		},
+	// 		"composed_of": ["logs-mappings"],
+	// 		"priority": 200,
+	// 		"version": 1,
+	// 		"_meta": {
+	// 			"description": "my custom template"
+	// 		}
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["index_patterns"] = t.indexPatterns
+
+	if t.dataStream != nil {
+		dataStream, err := t.dataStream.Source(false)
+		if err != nil {
+			return nil, err
+		}
+		options["data_stream"] = dataStream
+	}
+	if t.template != nil || t.lifecycle != nil {
+		template := make(map[string]interface{})
+		if t.template != nil {
+			src, err := t.template.Source(false)
+			if err != nil {
+				return nil, err
+			}
+			settings := src.(map[string]interface{})
+			// mappings are a sibling of the settings in index templates.
+			if mappings, ok := settings["mappings"]; ok {
+				template["mappings"] = mappings
+				delete(settings, "mappings")
+			}
+			if len(settings) > 0 {
+				template["settings"] = settings
+			}
+		}
+		if t.lifecycle != nil {
+			lifecycle, err := t.lifecycle.Source(false)
+			if err != nil {
+				return nil, err
+			}
+			template["lifecycle"] = lifecycle
+		}
+		options["template"] = template
+	}
+	if len(t.composedOf) > 0 {
+		options["composed_of"] = t.composedOf
+	}
+	if t.priority != nil {
+		options["priority"] = t.priority
+	}
+	if t.version != nil {
+		options["version"] = t.version
+	}
+	if t.meta != nil {
+		meta, err := t.meta.Source(false)
+		if err != nil {
+			return nil, err
+		}
+		options["_meta"] = meta
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[t.name] = options
+	return source, nil
+}
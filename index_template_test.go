@@ -0,0 +1,120 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIndexTemplateSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		it          *IndexTemplate
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name with IndexPatterns.",
+			it:          NewIndexTemplate("test").IndexPatterns("logs-*"),
+			includeName: true,
+			expected:    `{"test":{"index_patterns":["logs-*"]}}`,
+		},
+		// #1
+		{
+			desc: "Exclude Name with data stream template.",
+			it: NewIndexTemplate("test").
+				IndexPatterns("logs-*").
+				DataStream(NewDataStream().Hidden(false)).
+				Template(NewIndex().
+					NumberOfShards(1).
+					Mappings(NewMappings().Properties(NewDatatypeDate("@timestamp")))).
+				Lifecycle(NewDataStreamLifecycle().DataRetention("7d")).
+				ComposedOf("logs-mappings").
+				Priority(200).
+				Version(1).
+				Meta(NewMetaFieldMeta().RawJSON(`{"description":"my custom template"}`)),
+			includeName: false,
+			expected:    `{"_meta":{"description":"my custom template"},"composed_of":["logs-mappings"],"data_stream":{"hidden":false},"index_patterns":["logs-*"],"priority":200,"template":{"lifecycle":{"data_retention":"7d"},"mappings":{"properties":{"@timestamp":{"type":"date"}}},"settings":{"number_of_shards":1}},"version":1}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.it.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestIndexTemplateValidation(t *testing.T) {
+	tests := []struct {
+		desc  string
+		it    *IndexTemplate
+		valid bool
+	}{
+		// #0
+		{
+			desc: "Data stream template with @timestamp as date.",
+			it: NewIndexTemplate("test").
+				IndexPatterns("logs-*").
+				DataStream(NewDataStream()).
+				Template(NewIndex().Mappings(NewMappings().Properties(NewDatatypeDate("@timestamp")))),
+			valid: true,
+		},
+		// #1
+		{
+			desc:  "Missing IndexPatterns.",
+			it:    NewIndexTemplate("test"),
+			valid: false,
+		},
+		// #2
+		{
+			desc: "Data stream template with @timestamp as long.",
+			it: NewIndexTemplate("test").
+				IndexPatterns("logs-*").
+				DataStream(NewDataStream()).
+				Template(NewIndex().Mappings(NewMappings().Properties(NewDatatypeLong("@timestamp")))),
+			valid: false,
+		},
+		// #3
+		{
+			desc: "Regular index template with @timestamp as long.",
+			it: NewIndexTemplate("test").
+				IndexPatterns("logs-*").
+				Template(NewIndex().Mappings(NewMappings().Properties(NewDatatypeLong("@timestamp")))),
+			valid: true,
+		},
+		// #4
+		{
+			desc: "Invalid lifecycle data retention.",
+			it: NewIndexTemplate("test").
+				IndexPatterns("logs-*").
+				DataStream(NewDataStream()).
+				Lifecycle(NewDataStreamLifecycle().DataRetention("forever")),
+			valid: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.it.Validate(true)
+			if test.valid && err != nil {
+				t.Errorf("expected no error, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
@@ -107,6 +107,13 @@ func TestIndexSerialization(t *testing.T) {
 			includeName: true,
 			expected:    `{"index":{"lifecycle.name":"lifecycle_name","lifecycle.origination_date":1579442569,"lifecycle.parse_origination_date":true,"lifecycle.rollover_alias":"lifecycle_alias"}}`,
 		},
+		// #13
+		{
+			desc:        "Include Name with TimeSeries.",
+			i:           NewIndex().Mode("time_series").RoutingPath("host.name").MappingDimensionFieldsLimit(32).TimeSeriesStartTime("2099-01-01T00:00:00Z").TimeSeriesEndTime("2099-01-02T00:00:00Z").LookAheadTime("2h"),
+			includeName: true,
+			expected:    `{"index":{"look_ahead_time":"2h","mapping.dimension_fields.limit":32,"mode":"time_series","routing_path":["host.name"],"time_series.end_time":"2099-01-02T00:00:00Z","time_series.start_time":"2099-01-01T00:00:00Z"}}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -0,0 +1,183 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// validTimeSeriesMetrics time series metric types accepted by Elasticsearch in the
+// `time_series_metric` mapping parameter.
+var validTimeSeriesMetrics = map[string]bool{
+	"gauge":   true,
+	"counter": true,
+}
+
+// timeSeriesParams returns whether the datatype is a time series dimension, and its time series
+// metric type, from its `time_series_dimension` and `time_series_metric` mapping parameters.
+func timeSeriesParams(d Datatype) (dimension bool, metric string) {
+	src, err := d.Source(false)
+	if err != nil {
+		return false, ""
+	}
+	options, ok := src.(map[string]interface{})
+	if !ok {
+		return false, ""
+	}
+	if v, ok := options["time_series_dimension"].(*bool); ok && v != nil {
+		dimension = *v
+	}
+	metric, _ = options["time_series_metric"].(string)
+	return dimension, metric
+}
+
+// timeSeriesTimeLayouts layouts of the `strict_date_optional_time` format accepted by the
+// `index.time_series.start_time` and `index.time_series.end_time` settings.
+var timeSeriesTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02",
+	"2006-01",
+	"2006",
+}
+
+// parseTimeSeriesTime parses a time series time bound, either a `strict_date_optional_time`
+// date, in UTC unless specified, or epoch milliseconds.
+func parseTimeSeriesTime(value string) (time.Time, error) {
+	for _, layout := range timeSeriesTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	if millis, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(millis/1000, millis%1000*int64(time.Millisecond)).UTC(), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid date [%s]", value)
+}
+
+// routingPathPattern compiles a routing path, where `*` matches any characters, eg "host.*".
+func routingPathPattern(routingPath string) *regexp.Regexp {
+	return regexp.MustCompile("^" + strings.Replace(regexp.QuoteMeta(routingPath), `\*`, ".*", -1) + "$")
+}
+
+// ValidateTimeSeries validates the time series settings of the index against its mappings:
+// - `index.routing_path`, `index.time_series.start_time`, `index.time_series.end_time` and
+// `index.look_ahead_time` are only set in `time_series` mode.
+// - `index.routing_path` is set, or defaults to at least one `keyword` dimension, and only
+// matches `keyword` fields with `time_series_dimension` enabled.
+// - the number of dimensions does not exceed `index.mapping.dimension_fields.limit`.
+// - there are no `nested` fields, which time series indices do not support.
+// - `index.time_series.end_time` is after `index.time_series.start_time`.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.7/tsds.html
+// for details.
+func (i *Index) ValidateTimeSeries() error {
+	var invalid []string
+	if i.mode != "" {
+		if valid := map[string]bool{
+			"standard":    true,
+			"time_series": true,
+		}[i.mode]; !valid {
+			invalid = append(invalid, fmt.Sprintf("index.mode [%s] must be [standard] or [time_series]", i.mode))
+		}
+	}
+
+	if i.mode != "time_series" {
+		for _, s := range []struct {
+			name string
+			set  bool
+		}{
+			{"index.routing_path", len(i.routingPath) > 0},
+			{"index.time_series.start_time", i.timeSeriesStartTime != ""},
+			{"index.time_series.end_time", i.timeSeriesEndTime != ""},
+			{"index.look_ahead_time", i.lookAheadTime != ""},
+		} {
+			if s.set {
+				invalid = append(invalid, fmt.Sprintf("%s requires index.mode [time_series]", s.name))
+			}
+		}
+		if len(invalid) > 0 {
+			return fmt.Errorf("invalid time series index: %v", invalid)
+		}
+		return nil
+	}
+
+	if i.lookAheadTime != "" && !timeValuePattern.MatchString(i.lookAheadTime) {
+		invalid = append(invalid, fmt.Sprintf("index.look_ahead_time [%s] must be a time value", i.lookAheadTime))
+	}
+	var (
+		startTime, endTime time.Time
+		err                error
+	)
+	if i.timeSeriesStartTime != "" {
+		if startTime, err = parseTimeSeriesTime(i.timeSeriesStartTime); err != nil {
+			invalid = append(invalid, fmt.Sprintf("index.time_series.start_time [%s] must be an "+
+				"ISO 8601 date or epoch milliseconds", i.timeSeriesStartTime))
+		}
+	}
+	if i.timeSeriesEndTime != "" {
+		if endTime, err = parseTimeSeriesTime(i.timeSeriesEndTime); err != nil {
+			invalid = append(invalid, fmt.Sprintf("index.time_series.end_time [%s] must be an "+
+				"ISO 8601 date or epoch milliseconds", i.timeSeriesEndTime))
+		}
+	}
+	if !startTime.IsZero() && !endTime.IsZero() && !endTime.After(startTime) {
+		invalid = append(invalid, fmt.Sprintf("index.time_series.end_time [%s] must be after "+
+			"index.time_series.start_time [%s]", i.timeSeriesEndTime, i.timeSeriesStartTime))
+	}
+
+	var (
+		fields            []string
+		keywordDimensions = make(map[string]bool)
+		dimensions        int
+	)
+	if m := i.mappings; m != nil {
+		walkDatatypes("", m.properties, func(path string, d Datatype) {
+			switch d.(type) {
+			case *DatatypeNested:
+				invalid = append(invalid, fmt.Sprintf("field [%s]: nested fields are not supported by "+
+					"time series indices", path))
+				return
+			case *DatatypeObject:
+				return
+			}
+			fields = append(fields, path)
+			if dimension, _ := timeSeriesParams(d); dimension {
+				dimensions++
+				if _, ok := d.(*DatatypeKeyword); ok {
+					keywordDimensions[path] = true
+				}
+			}
+		})
+	}
+
+	if limit := intOrDefault(i.mappingDimensionFieldsLimit, 16); dimensions > limit {
+		invalid = append(invalid, fmt.Sprintf("%d time_series_dimension fields exceed "+
+			"index.mapping.dimension_fields.limit %d, set index.mapping.dimension_fields.limit to "+
+			"at least %d", dimensions, limit, dimensions))
+	}
+	if len(i.routingPath) == 0 && len(keywordDimensions) == 0 {
+		invalid = append(invalid, "index.routing_path is required without keyword time_series_dimension fields")
+	}
+	for _, routingPath := range i.routingPath {
+		pattern := routingPathPattern(routingPath)
+		for _, path := range fields {
+			if pattern.MatchString(path) && !keywordDimensions[path] {
+				invalid = append(invalid, fmt.Sprintf("index.routing_path [%s] matches field [%s], "+
+					"which is not a keyword time_series_dimension field", routingPath, path))
+			}
+		}
+	}
+
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid time series index: %v", invalid)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIndexValidateTimeSeries(t *testing.T) {
+	metrics := NewMappings().Properties(
+		NewDatatypeDate("@timestamp"),
+		NewDatatypeObject("host").Properties(
+			NewDatatypeKeyword("name").TimeSeriesDimension(true),
+			NewDatatypeIP("ip").TimeSeriesDimension(true),
+		),
+		NewDatatypeDouble("cpu").TimeSeriesMetric("gauge"),
+	)
+	tests := []struct {
+		desc     string
+		i        *Index
+		expected []string
+	}{
+		// #0
+		{
+			desc: "Standard index.",
+			i:    NewIndex().Mappings(NewMappings().Properties(NewDatatypeNested("comments"))),
+		},
+		// #1
+		{
+			desc:     "Time series settings on standard index.",
+			i:        NewIndex().RoutingPath("host.name").LookAheadTime("2h"),
+			expected: []string{"index.routing_path requires index.mode [time_series]", "index.look_ahead_time requires index.mode [time_series]"},
+		},
+		// #2
+		{
+			desc: "Time series index with routing path.",
+			i: NewIndex().Mode("time_series").RoutingPath("host.name").
+				TimeSeriesStartTime("2099-01-01T00:00:00Z").TimeSeriesEndTime("2099-01-02T00:00:00Z").
+				Mappings(metrics),
+		},
+		// #3
+		{
+			desc: "Time series index with default routing path.",
+			i:    NewIndex().Mode("time_series").Mappings(metrics),
+		},
+		// #4
+		{
+			desc:     "Time series index without dimensions.",
+			i:        NewIndex().Mode("time_series").Mappings(NewMappings().Properties(NewDatatypeDate("@timestamp"))),
+			expected: []string{"index.routing_path is required without keyword time_series_dimension fields"},
+		},
+		// #5
+		{
+			desc:     "Routing path matching non keyword dimensions.",
+			i:        NewIndex().Mode("time_series").RoutingPath("host.*").Mappings(metrics),
+			expected: []string{"index.routing_path [host.*] matches field [host.ip], which is not a keyword time_series_dimension field"},
+		},
+		// #6
+		{
+			desc:     "Dimensions exceeding limit.",
+			i:        NewIndex().Mode("time_series").MappingDimensionFieldsLimit(1).Mappings(metrics),
+			expected: []string{"2 time_series_dimension fields exceed index.mapping.dimension_fields.limit 1, set index.mapping.dimension_fields.limit to at least 2"},
+		},
+		// #7
+		{
+			desc: "Nested fields and invalid time bounds.",
+			i: NewIndex().Mode("time_series").LookAheadTime("2 hours").
+				TimeSeriesStartTime("2099-01-02T00:00:00Z").TimeSeriesEndTime("2099-01-01T00:00:00Z").
+				Mappings(NewMappings().Properties(
+					NewDatatypeKeyword("host").TimeSeriesDimension(true),
+					NewDatatypeNested("tags"),
+				)),
+			expected: []string{
+				"index.look_ahead_time [2 hours] must be a time value",
+				"index.time_series.end_time [2099-01-01T00:00:00Z] must be after index.time_series.start_time [2099-01-02T00:00:00Z]",
+				"field [tags]: nested fields are not supported by time series indices",
+			},
+		},
+		// #8
+		{
+			desc:     "Invalid mode.",
+			i:        NewIndex().Mode("metrics"),
+			expected: []string{"index.mode [metrics] must be [standard] or [time_series]"},
+		},
+		// #9
+		{
+			desc: "Time series index with date only and epoch milliseconds time bounds.",
+			i: NewIndex().Mode("time_series").
+				TimeSeriesStartTime("2099-01-01").TimeSeriesEndTime("4070995200000").
+				Mappings(metrics),
+		},
+		// #10
+		{
+			desc: "Epoch milliseconds and date only time bounds out of order.",
+			i: NewIndex().Mode("time_series").
+				TimeSeriesStartTime("4070995200000").TimeSeriesEndTime("2099-01-01").
+				Mappings(metrics),
+			expected: []string{
+				"index.time_series.end_time [2099-01-01] must be after index.time_series.start_time [4070995200000]",
+			},
+		},
+		// #11
+		{
+			desc: "Invalid time bounds.",
+			i: NewIndex().Mode("time_series").
+				TimeSeriesStartTime("01/01/2099").TimeSeriesEndTime("tomorrow").
+				Mappings(metrics),
+			expected: []string{
+				"index.time_series.start_time [01/01/2099] must be an ISO 8601 date or epoch milliseconds",
+				"index.time_series.end_time [tomorrow] must be an ISO 8601 date or epoch milliseconds",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.i.ValidateTimeSeries()
+			if len(test.expected) == 0 {
+				if err != nil {
+					t.Errorf("expected valid, got: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			for _, expected := range test.expected {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error to contain %q, got: %v", expected, err)
+				}
+			}
+		})
+	}
+}
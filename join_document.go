@@ -0,0 +1,134 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// JoinDocument document of a parent/child relation of a join datatype, holding the value of
+// the join field and the routing to index the document with. Child documents must be indexed
+// in the same shard as their parent, using the `routing` parameter of the index request.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/parent-join.html
+// for details.
+type JoinDocument struct {
+	field    string
+	name     string
+	parent   string
+	routing  string
+	document map[string]interface{}
+}
+
+// ParentDocument initializes a new JoinDocument of the root parent relation name, which is not
+// routed.
+func (j *DatatypeJoin) ParentDocument(name string) (*JoinDocument, error) {
+	if _, isParent := j.relation(name); !isParent {
+		return nil, fmt.Errorf("relation [%s] is not a parent of join field [%s]", name, j.name)
+	}
+	if _, isChild := j.parents()[name]; isChild {
+		return nil, fmt.Errorf("relation [%s] is a child of join field [%s] and requires a parent", name, j.name)
+	}
+	return &JoinDocument{
+		field:    j.name,
+		name:     name,
+		document: make(map[string]interface{}),
+	}, nil
+}
+
+// ChildDocument initializes a new JoinDocument of the child relation name, whose parent
+// document has the id parentID. The document is routed to the parent, unless routing is set.
+// Children of children, ie grandchildren, must be routed to their root parent, ie their
+// grandparent, and require routing to be set.
+func (j *DatatypeJoin) ChildDocument(name, parentID, routing string) (*JoinDocument, error) {
+	if _, isChild := j.parents()[name]; !isChild {
+		return nil, fmt.Errorf("relation [%s] is not a child of join field [%s]", name, j.name)
+	}
+	if parentID == "" {
+		return nil, fmt.Errorf("child [%s] of join field [%s] requires a parent id", name, j.name)
+	}
+	lineage := j.lineage(name)
+	if lineage == nil {
+		return nil, fmt.Errorf("child [%s] of join field [%s] is part of a relation cycle", name, j.name)
+	}
+	if routing == "" {
+		if len(lineage) > 2 {
+			return nil, fmt.Errorf("child [%s] of join field [%s] requires the routing of its root parent [%s]",
+				name, j.name, lineage[0])
+		}
+		routing = parentID
+	}
+	return &JoinDocument{
+		field:    j.name,
+		name:     name,
+		parent:   parentID,
+		routing:  routing,
+		document: make(map[string]interface{}),
+	}, nil
+}
+
+// Document sets the other fields of the document.
+func (d *JoinDocument) Document(document map[string]interface{}) *JoinDocument {
+	for k, v := range document {
+		d.document[k] = v
+	}
+	return d
+}
+
+// Routing returns the value of the `routing` parameter to index the document with, which is
+// empty for root parents.
+func (d *JoinDocument) Routing() string {
+	return d.routing
+}
+
+// Source returns the serializable JSON for the source builder.
+func (d *JoinDocument) Source() (interface{}, error) {
+	// {
+	// 	"text": "This is an answer",
+	// 	"my_join_field": {
+	// 		"name": "answer",
+	// 		"parent": "1"
+	// 	}
+	// }
+	source := make(map[string]interface{})
+	for k, v := range d.document {
+		source[k] = v
+	}
+
+	join := make(map[string]interface{})
+	join["name"] = d.name
+	if d.parent != "" {
+		join["parent"] = d.parent
+	}
+	source[d.field] = join
+	return source, nil
+}
+
+// ValidateJoin validates the join datatypes of the mappings, as only one join field is allowed
+// per index, and the relations of the join field.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/parent-join.html#_parent_join_restrictions
+// for details.
+func (m *Mappings) ValidateJoin() error {
+	var (
+		invalid []string
+		joins   []string
+	)
+	walkDatatypes("", m.properties, func(path string, d Datatype) {
+		j, ok := d.(*DatatypeJoin)
+		if !ok {
+			return
+		}
+		joins = append(joins, path)
+		if err := j.ValidateRelations(); err != nil {
+			invalid = append(invalid, fmt.Sprintf("join field [%s]: %v", path, err))
+		}
+	})
+	if len(joins) > 1 {
+		invalid = append(invalid, fmt.Sprintf("only one join field is allowed per index, got %v", joins))
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid join fields: %v", invalid)
+	}
+	return nil
+}
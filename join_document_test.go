@@ -0,0 +1,126 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJoinDocumentSerialization(t *testing.T) {
+	j := NewDatatypeJoin("my_join_field").Relations(NewRelation("question", "answer"), NewRelation("answer", "vote"))
+	tests := []struct {
+		desc            string
+		document        func() (*JoinDocument, error)
+		expected        string
+		expectedRouting string
+	}{
+		// #0
+		{
+			desc: "Parent document.",
+			document: func() (*JoinDocument, error) {
+				d, err := j.ParentDocument("question")
+				if err != nil {
+					return nil, err
+				}
+				return d.Document(map[string]interface{}{"text": "This is a question"}), nil
+			},
+			expected: `{"my_join_field":{"name":"question"},"text":"This is a question"}`,
+		},
+		// #1
+		{
+			desc: "Child document routed to parent.",
+			document: func() (*JoinDocument, error) {
+				return j.ChildDocument("answer", "1", "")
+			},
+			expected:        `{"my_join_field":{"name":"answer","parent":"1"}}`,
+			expectedRouting: "1",
+		},
+		// #2
+		{
+			desc: "Grandchild document routed to root parent.",
+			document: func() (*JoinDocument, error) {
+				return j.ChildDocument("vote", "2", "1")
+			},
+			expected:        `{"my_join_field":{"name":"vote","parent":"2"}}`,
+			expectedRouting: "1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			d, err := test.document()
+			if err != nil {
+				t.Fatal(err)
+			}
+			src, err := d.Source()
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+			if got := d.Routing(); got != test.expectedRouting {
+				t.Errorf("expected routing %q, got: %q", test.expectedRouting, got)
+			}
+		})
+	}
+	if _, err := j.ParentDocument("answer"); err == nil {
+		t.Error("expected child used as parent document error, got nil")
+	}
+	if _, err := j.ChildDocument("question", "1", ""); err == nil {
+		t.Error("expected root parent used as child document error, got nil")
+	}
+	if _, err := j.ChildDocument("answer", "", ""); err == nil {
+		t.Error("expected missing parent id error, got nil")
+	}
+	if _, err := j.ChildDocument("vote", "2", ""); err == nil {
+		t.Error("expected missing grandchild routing error, got nil")
+	}
+}
+
+func TestMappingsValidateJoin(t *testing.T) {
+	tests := []struct {
+		desc  string
+		m     *Mappings
+		valid bool
+	}{
+		// #0
+		{
+			desc:  "Single join field.",
+			m:     NewMappings().Properties(NewDatatypeJoin("my_join_field").Relations(NewRelation("question", "answer"))),
+			valid: true,
+		},
+		// #1
+		{
+			desc: "Multiple join fields.",
+			m: NewMappings().Properties(
+				NewDatatypeJoin("my_join_field").Relations(NewRelation("question", "answer")),
+				NewDatatypeObject("meta").Properties(NewDatatypeJoin("other_join_field").Relations(NewRelation("blog", "post"))),
+			),
+			valid: false,
+		},
+		// #2
+		{
+			desc:  "Join field with relation cycle.",
+			m:     NewMappings().Properties(NewDatatypeJoin("my_join_field").Relations(NewRelation("question", "answer"), NewRelation("answer", "question"))),
+			valid: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.m.Validate()
+			if test.valid && err != nil {
+				t.Errorf("expected no error, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ type Mappings struct {
 	dynamicTemplates []*DynamicTemplate
 
 	// dynamic mapping fields
+	dynamic            DynamicMode
 	dateDetection      *bool
 	dynamicDateFormats []*DateFormat
 	numericDetection   *bool
@@ -31,7 +32,13 @@ type Mappings struct {
 	routing    *MetaFieldRouting
 	meta       *MetaFieldMeta
 
+	dataStreamTimestamp *MetaFieldDataStreamTimestamp
+
+	// runtime fields
+	runtime []*RuntimeField
+
 	// properties fields
+	subobjects *bool
 	properties []Datatype
 }
 
@@ -40,6 +47,7 @@ func NewMappings() *Mappings {
 	return &Mappings{
 		dynamicTemplates:   make([]*DynamicTemplate, 0),
 		dynamicDateFormats: make([]*DateFormat, 0),
+		runtime:            make([]*RuntimeField, 0),
 		properties:         make([]Datatype, 0),
 	}
 }
@@ -53,6 +61,17 @@ func (m *Mappings) DynamicTemplates(dynamicTemplates ...*DynamicTemplate) *Mappi
 	return m
 }
 
+// Dynamic sets how new fields detected in documents are handled, which can be set to true,
+// false, "strict" or "runtime". Inner objects inherit the setting unless they override it.
+// Defaults to true.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.11/dynamic.html
+// for details.
+func (m *Mappings) Dynamic(dynamic DynamicMode) *Mappings {
+	m.dynamic = dynamic
+	return m
+}
+
 // DateDetection sets whether new string fields are checked to see whether their contents match
 // any of the date patterns specified in `dynamic_date_formats`. If a match is found, a new `date`
 // field is added with the corresponding format. Defaults to true.
@@ -131,6 +150,35 @@ func (m *Mappings) Meta(meta *MetaFieldMeta) *Mappings {
 	return m
 }
 
+// DataStreamTimestamp sets whether documents require a `@timestamp` field, which is enabled
+// for the backing indices of data streams.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.9/data-streams.html
+// for details.
+func (m *Mappings) DataStreamTimestamp(dataStreamTimestamp *MetaFieldDataStreamTimestamp) *Mappings {
+	m.dataStreamTimestamp = dataStreamTimestamp
+	return m
+}
+
+// Runtime sets the runtime fields, which are evaluated at query time instead of being indexed.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.11/runtime.html
+// for details.
+func (m *Mappings) Runtime(runtime ...*RuntimeField) *Mappings {
+	m.runtime = append(m.runtime, runtime...)
+	return m
+}
+
+// Subobjects sets whether the document can hold objects, when disabled, field names containing
+// dots are stored as leaf fields, eg "metrics.time.max". Defaults to true.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.3/subobjects.html
+// for details.
+func (m *Mappings) Subobjects(subobjects bool) *Mappings {
+	m.subobjects = &subobjects
+	return m
+}
+
 // Properties sets the list of fields or properties pertinent to the document.
 func (m *Mappings) Properties(properties ...Datatype) *Mappings {
 	m.properties = append(m.properties, properties...)
@@ -139,7 +187,21 @@ func (m *Mappings) Properties(properties ...Datatype) *Mappings {
 
 // Validate validates Mappings.
 func (m *Mappings) Validate() error {
-	return nil
+	var invalid []string
+	if m.dynamic != "" && !validDynamicModes[m.dynamic] {
+		invalid = append(invalid, "Dynamic")
+	}
+	// mappings with subobjects disabled cannot hold object or nested properties.
+	if m.subobjects != nil && !*m.subobjects && hasSubobjects(m.properties) {
+		invalid = append(invalid, "Subobjects")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid values: %v", invalid)
+	}
+	if err := m.ValidateRuntimeFields(); err != nil {
+		return err
+	}
+	return m.ValidateJoin()
 }
 
 // Source returns the serializable JSON for the source builder.
@@ -156,6 +218,7 @@ func (m *Mappings) Source(includeName bool) (interface{}, error) {
 	// 				}
 	// 			}
 	// 		],
+	// 		"dynamic": "strict",
 	// 		"date_detection": false,
 	// 		"dynamic_date_formats": ["strict_date_optional_time","yyyy/MM/dd HH:mm:ss Z||yyyy/MM/dd Z"],
 	// 		"numeric_detection": false,
@@ -186,6 +249,18 @@ func (m *Mappings) Source(includeName bool) (interface{}, error) {
 	// 				"max": "1.3"
 	// 			}
 	// 		},
+	// 		"_data_stream_timestamp": {
+	// 			"enabled": true
+	// 		},
+	// 		"runtime": {
+	// 			"day_of_week": {
+	// 				"type": "keyword",
+	// 				"script": {
+	// 					"source": "emit(doc['@timestamp'].value.dayOfWeekEnum.toString())"
+	// 				}
+	// 			}
+	// 		},
+	// 		"subobjects": true,
 	// 		"properties": {
 	// 			"field_name": {
 	// 				"type": "text",
@@ -209,6 +284,9 @@ func (m *Mappings) Source(includeName bool) (interface{}, error) {
 		}
 		options["dynamic_templates"] = dynamicTemplates
 	}
+	if m.dynamic != "" {
+		options["dynamic"] = m.dynamic.value()
+	}
 	if m.dateDetection != nil {
 		options["date_detection"] = m.dateDetection
 	}
@@ -262,6 +340,27 @@ func (m *Mappings) Source(includeName bool) (interface{}, error) {
 		}
 		options["_meta"] = meta
 	}
+	if m.dataStreamTimestamp != nil {
+		dataStreamTimestamp, err := m.dataStreamTimestamp.Source(false)
+		if err != nil {
+			return nil, err
+		}
+		options["_data_stream_timestamp"] = dataStreamTimestamp
+	}
+	if len(m.runtime) > 0 {
+		runtime := make(map[string]interface{})
+		for _, f := range m.runtime {
+			field, err := f.Source(false)
+			if err != nil {
+				return nil, err
+			}
+			runtime[f.Name()] = field
+		}
+		options["runtime"] = runtime
+	}
+	if m.subobjects != nil {
+		options["subobjects"] = m.subobjects
+	}
 	if len(m.properties) > 0 {
 		properties := make(map[string]interface{})
 		for _, p := range m.properties {
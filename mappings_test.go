@@ -44,6 +44,20 @@ func TestMappingsSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"properties":{"field_1":{"analyzer":"standard","type":"text"},"field_2":{"store":false,"type":"keyword"}}}`,
 		},
+		// #4
+		{
+			desc:        "Exclude Name with Dynamic and Subobjects.",
+			m:           NewMappings().Dynamic(DynamicModeStrict).Subobjects(false).Properties(NewDatatypeKeyword("metrics.time")),
+			includeName: false,
+			expected:    `{"dynamic":"strict","properties":{"metrics.time":{"type":"keyword"}},"subobjects":false}`,
+		},
+		// #5
+		{
+			desc:        "Exclude Name with Runtime.",
+			m:           NewMappings().Runtime(NewRuntimeField("status", "long")),
+			includeName: false,
+			expected:    `{"runtime":{"status":{"type":"long"}}}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -60,4 +74,7 @@ func TestMappingsSerialization(t *testing.T) {
 			}
 		})
 	}
+	if err := NewMappings().Subobjects(false).Properties(NewDatatypeNested("inner")).Validate(); err == nil {
+		t.Error("expected Mappings validation error, got nil")
+	}
 }
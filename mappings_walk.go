@@ -0,0 +1,31 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+// walkDatatypes calls fn for every datatype of properties with its full dotted path, including
+// the properties of object and nested datatypes and multi-fields.
+func walkDatatypes(path string, properties []Datatype, fn func(path string, d Datatype)) {
+	for _, p := range properties {
+		fullPath := p.Name()
+		if path != "" {
+			fullPath = path + "." + fullPath
+		}
+		fn(fullPath, p)
+		switch t := p.(type) {
+		case *DatatypeObject:
+			walkDatatypes(fullPath, t.properties, fn)
+		case *DatatypeNested:
+			walkDatatypes(fullPath, t.properties, fn)
+		case *DatatypeText:
+			walkDatatypes(fullPath, t.fields, fn)
+		case *DatatypeKeyword:
+			walkDatatypes(fullPath, t.fields, fn)
+		case *DatatypeICUCollationKeyword:
+			walkDatatypes(fullPath, t.fields, fn)
+		case *DatatypeMatchOnlyText:
+			walkDatatypes(fullPath, t.fields, fn)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+// MetaFieldDataStreamTimestamp Document Meta-Field which requires every document of a data
+// stream to have a `@timestamp` field mapped as `date` or `date_nanos`. Enabled by default
+// for the backing indices of data streams.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.9/data-streams.html
+// for details.
+type MetaFieldDataStreamTimestamp struct {
+	enabled *bool
+}
+
+// NewMetaFieldDataStreamTimestamp initializes a new MetaFieldDataStreamTimestamp.
+func NewMetaFieldDataStreamTimestamp() *MetaFieldDataStreamTimestamp {
+	return &MetaFieldDataStreamTimestamp{}
+}
+
+// Enabled sets whether to enable or disable the `_data_stream_timestamp` meta-field. Must not
+// be disabled for data streams.
+func (t *MetaFieldDataStreamTimestamp) Enabled(enabled bool) *MetaFieldDataStreamTimestamp {
+	t.enabled = &enabled
+	return t
+}
+
+// Validate validates MetaFieldDataStreamTimestamp.
+func (t *MetaFieldDataStreamTimestamp) Validate() error {
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (t *MetaFieldDataStreamTimestamp) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"_data_stream_timestamp": {
+	// 		"enabled": true
+	// 	}
+	// }
+	options := make(map[string]interface{})
+
+	if t.enabled != nil {
+		options["enabled"] = t.enabled
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source["_data_stream_timestamp"] = options
+	return source, nil
+}
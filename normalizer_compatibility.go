@@ -0,0 +1,121 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// normalizerTokenFilters token filter types which work on a per-character basis and can be
+// used in normalizers.
+var normalizerTokenFilters = map[string]bool{
+	"arabic_normalization":  true,
+	"asciifolding":          true,
+	"bengali_normalization": true,
+	"cjk_width":             true,
+	"decimal_digit":         true,
+	"elision":               true,
+	"german_normalization":  true,
+	"hindi_normalization":   true,
+	"indic_normalization":   true,
+	"lowercase":             true,
+	"pattern_replace":       true,
+	"persian_normalization": true,
+	"scandinavian_folding":  true,
+	"serbian_normalization": true,
+	"sorani_normalization":  true,
+	"trim":                  true,
+	"uppercase":             true,
+	"icu_normalizer":        true,
+	"icu_folding":           true,
+	"icu_transform":         true,
+}
+
+// normalizerCharFilters character filter types which work on a per-character basis and can be
+// used in normalizers.
+var normalizerCharFilters = map[string]bool{
+	"mapping":         true,
+	"pattern_replace": true,
+	"icu_normalizer":  true,
+}
+
+// normalizerTokenFilterReason returns why a token filter type cannot be used in a normalizer.
+func normalizerTokenFilterReason(typ string) string {
+	switch typ {
+	case "synonym", "synonym_graph", "ngram", "edge_ngram", "shingle", "word_delimiter",
+		"word_delimiter_graph", "common_grams", "dictionary_decompounder", "hyphenation_decompounder",
+		"multiplexer", "pattern_capture", "keyword_repeat", "cjk_bigram", "min_hash", "phonetic":
+		return "may emit multiple tokens, while a normalizer must emit exactly one token"
+	case "stemmer", "snowball", "kstem", "porter_stem", "hunspell", "stemmer_override", "polish_stem",
+		"kuromoji_stemmer", "kuromoji_baseform", "kuromoji_readingform", "nori_readingform":
+		return "transforms whole words, while a normalizer only applies per-character transformations"
+	case "stop", "length", "keep", "keep_types", "limit", "unique", "remove_duplicates", "ja_stop",
+		"smartcn_stop", "kuromoji_part_of_speech", "nori_part_of_speech", "predicate_token_filter", "condition":
+		return "may remove the token, while a normalizer must emit exactly one token"
+	}
+	return "does not work on a per-character basis"
+}
+
+// componentType returns the `type` of a customised analysis component.
+func componentType(c interface {
+	Source(includeName bool) (interface{}, error)
+}) string {
+	src, err := c.Source(false)
+	if err != nil {
+		return ""
+	}
+	if options, ok := src.(map[string]interface{}); ok {
+		if typ, ok := options["type"].(string); ok {
+			return typ
+		}
+	}
+	return ""
+}
+
+// ValidateNormalizers validates that the custom normalizers only reference token filters and
+// character filters which work on a per-character basis, as Elasticsearch rejects tokenizing
+// or multi-token filters such as `synonym`, `ngram`, `stemmer` or `shingle` in normalizers.
+// Filter names are resolved through the customised filters of the Analysis first, then as
+// built-in filters.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-normalizers.html
+// for details.
+func (a *Analysis) ValidateNormalizers() error {
+	var invalid []string
+	for _, n := range a.normalizer {
+		c, ok := n.(*NormalizerCustom)
+		if !ok {
+			continue
+		}
+		for _, name := range c.charFilter {
+			typ := name
+			for _, f := range a.charFilter {
+				if f.Name() == name {
+					typ = componentType(f)
+					break
+				}
+			}
+			if !normalizerCharFilters[typ] {
+				invalid = append(invalid, fmt.Sprintf("normalizer [%s]: char_filter [%s] of type [%s] does "+
+					"not work on a per-character basis", c.name, name, typ))
+			}
+		}
+		for _, name := range c.filter {
+			typ := name
+			for _, f := range a.filter {
+				if f.Name() == name {
+					typ = componentType(f)
+					break
+				}
+			}
+			if !normalizerTokenFilters[typ] {
+				invalid = append(invalid, fmt.Sprintf("normalizer [%s]: filter [%s] of type [%s] %s",
+					c.name, name, typ, normalizerTokenFilterReason(typ)))
+			}
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid normalizers: %v", invalid)
+	}
+	return nil
+}
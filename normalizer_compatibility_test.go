@@ -0,0 +1,76 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAnalysisValidateNormalizers(t *testing.T) {
+	tests := []struct {
+		desc     string
+		a        *Analysis
+		expected []string
+	}{
+		// #0
+		{
+			desc: "Per-character filters.",
+			a: NewAnalysis().
+				Normalizer(NewNormalizerCustom("test").CharFilter("quote").Filter("lowercase", "asciifolding", "custom_elision")).
+				Filter(NewTokenFilterElision("custom_elision").Articles("l")).
+				CharFilter(NewCharacterFilterMappingChar("quote").RawMappings("« => \"")),
+		},
+		// #1
+		{
+			desc: "Trim and pattern replace filters.",
+			a: NewAnalysis().
+				Normalizer(NewNormalizerCustom("test").Filter("lowercase", "trim", "dashes")).
+				Filter(NewTokenFilterPatternReplace("dashes").Pattern("-+").Replacement("-")),
+		},
+		// #2
+		{
+			desc: "Built-in multi-token and html_strip filters.",
+			a:    NewAnalysis().Normalizer(NewNormalizerCustom("test").CharFilter("html_strip").Filter("lowercase", "shingle")),
+			expected: []string{
+				"normalizer [test]: char_filter [html_strip] of type [html_strip] does not work on a per-character basis",
+				"normalizer [test]: filter [shingle] of type [shingle] may emit multiple tokens",
+			},
+		},
+		// #3
+		{
+			desc: "Customised synonym and stemmer filters.",
+			a: NewAnalysis().
+				Normalizer(NewNormalizerCustom("test").Filter("my_synonyms", "my_stemmer")).
+				Filter(
+					NewTokenFilterSynonym("my_synonyms").RawSynonyms("a, b"),
+					NewTokenFilterStemmer("my_stemmer").Language("english"),
+				),
+			expected: []string{
+				"normalizer [test]: filter [my_synonyms] of type [synonym] may emit multiple tokens",
+				"normalizer [test]: filter [my_stemmer] of type [stemmer] transforms whole words",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.a.Validate()
+			if len(test.expected) == 0 {
+				if err != nil {
+					t.Errorf("expected valid, got: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			for _, expected := range test.expected {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error to contain %q, got: %v", expected, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "math"
+
+// maxHalfFloat largest finite value of a half-precision floating point number.
+const maxHalfFloat = 65504
+
+// isFinite returns whether v is neither infinite nor NaN, as Elasticsearch only accepts finite
+// values for floating point datatypes.
+func isFinite(v float64) bool {
+	return !math.IsInf(v, 0) && !math.IsNaN(v)
+}
+
+// halfFloatValue returns the value stored by a `half_float` field for v, which is first parsed
+// as a single-precision float, then rounded to the nearest half-precision float, ties to even.
+// Values beyond the half-precision range round to infinity.
+func halfFloatValue(v float64) float64 {
+	v = float64(float32(v))
+	if v == 0 || !isFinite(v) {
+		return v
+	}
+	a := math.Abs(v)
+	_, exp := math.Frexp(a)
+	// a is in [2^(exp-1), 2^exp), half floats have 10 explicit mantissa bits, and subnormals
+	// share the precision of the smallest normal exponent, 2^-14.
+	e := exp - 1
+	if e < -14 {
+		e = -14
+	}
+	ulp := math.Ldexp(1, e-10)
+	rounded := math.RoundToEven(a/ulp) * ulp
+	if rounded > maxHalfFloat {
+		rounded = math.Inf(1)
+	}
+	return math.Copysign(rounded, v)
+}
+
+// scaledFloatValue returns the value stored by a `scaled_float` field for v, which is
+// multiplied by the scaling factor and rounded to the closest long value, like Java's
+// Math.round.
+func scaledFloatValue(v float64, scalingFactor float64) float64 {
+	return math.Floor(v*scalingFactor+0.5) / scalingFactor
+}
@@ -0,0 +1,713 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// regexFlags Java regular expression flags accepted by Elasticsearch in `flags` parameters.
+var regexFlags = map[string]bool{
+	"CASE_INSENSITIVE":   true,
+	"MULTILINE":          true,
+	"DOTALL":             true,
+	"UNICODE_CASE":       true,
+	"CANON_EQ":           true,
+	"UNIX_LINES":         true,
+	"LITERAL":            true,
+	"COMMENTS":           true,
+	"UNICODE_CHAR_CLASS": true,
+}
+
+// javaPropertyNames character property names accepted by Java in `\p{...}` without an "In"
+// or "Is" prefix, in addition to the Unicode general categories.
+var javaPropertyNames = map[string]bool{
+	"Cn": true, "LC": true, "LD": true, "L1": true, "all": true,
+	"Lower": true, "Upper": true, "ASCII": true, "Alpha": true, "Digit": true, "Alnum": true,
+	"Punct": true, "Graph": true, "Print": true, "Blank": true, "Cntrl": true, "XDigit": true,
+	"Space": true, "javaLowerCase": true, "javaUpperCase": true, "javaWhitespace": true,
+	"javaMirrored": true, "javaAlphabetic": true, "javaIdeographic": true, "javaTitleCase": true,
+	"javaDigit": true, "javaDefined": true, "javaLetter": true, "javaLetterOrDigit": true,
+	"javaJavaIdentifierStart": true, "javaJavaIdentifierPart": true,
+	"javaUnicodeIdentifierStart": true, "javaUnicodeIdentifierPart": true,
+	"javaIdentifierIgnorable": true, "javaSpaceChar": true, "javaISOControl": true,
+}
+
+// ValidateRegexFlags validates Java regular expression flags, eg "CASE_INSENSITIVE|COMMENTS".
+// Every value may contain several flags separated by "|".
+//
+// See https://docs.oracle.com/javase/8/docs/api/java/util/regex/Pattern.html#field.summary
+// for details.
+func ValidateRegexFlags(flags ...string) error {
+	var unknown []string
+	for _, flag := range splitRegexFlags(flags...) {
+		if !regexFlags[flag] {
+			unknown = append(unknown, flag)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown regex flags: %v", unknown)
+	}
+	return nil
+}
+
+// splitRegexFlags splits flags values on "|".
+func splitRegexFlags(flags ...string) []string {
+	var split []string
+	for _, value := range flags {
+		for _, flag := range strings.Split(value, "|") {
+			if flag = strings.TrimSpace(flag); flag != "" {
+				split = append(split, flag)
+			}
+		}
+	}
+	return split
+}
+
+// ValidateJavaRegex validates a Java regular expression, as compiled by Elasticsearch for the
+// `pattern` tokenizer, `pattern` analyzer, `pattern_replace` character filter, `pattern_replace`
+// and `pattern_capture` token filters and `regex` dynamic templates. Constructs which Java
+// rejects, or which Go's regexp accepts but Java interprets differently, are reported as
+// errors. The flags are taken into account, eg whitespace is ignored with "COMMENTS".
+//
+// See https://docs.oracle.com/javase/8/docs/api/java/util/regex/Pattern.html
+// for details.
+func ValidateJavaRegex(pattern string, flags ...string) error {
+	if err := ValidateRegexFlags(flags...); err != nil {
+		return err
+	}
+	errs, _ := scanJavaRegex(pattern, flags...)
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid java regex %q: %v", pattern, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// JavaRegexDifferences returns the constructs of a Java regular expression which Go's regexp
+// does not support or interprets differently, eg lookarounds, backreferences or possessive
+// quantifiers. Such patterns are valid for Elasticsearch but cannot be tested with Go.
+func JavaRegexDifferences(pattern string, flags ...string) []string {
+	_, diffs := scanJavaRegex(pattern, flags...)
+	return diffs
+}
+
+// scanJavaRegex scans a Java regular expression, returning the errors Java would raise and the
+// differences with Go's regexp.
+func scanJavaRegex(pattern string, flags ...string) (errs []string, diffs []string) {
+	comments := false
+	for _, flag := range splitRegexFlags(flags...) {
+		switch flag {
+		case "LITERAL":
+			return nil, nil
+		case "COMMENTS":
+			comments = true
+		}
+	}
+
+	r := []rune(pattern)
+	var (
+		groups     []int
+		canRepeat  bool
+		quantified bool
+	)
+	errorf := func(i int, format string, args ...interface{}) {
+		errs = append(errs, fmt.Sprintf("at %d: ", i)+fmt.Sprintf(format, args...))
+	}
+	difff := func(i int, format string, args ...interface{}) {
+		diffs = append(diffs, fmt.Sprintf("at %d: ", i)+fmt.Sprintf(format, args...))
+	}
+
+	for i := 0; i < len(r); i++ {
+		c := r[i]
+		if comments && unicode.IsSpace(c) {
+			continue
+		}
+		if comments && c == '#' {
+			for i < len(r) && r[i] != '\n' {
+				i++
+			}
+			continue
+		}
+		switch c {
+		case '\\':
+			i = scanJavaEscape(r, i, false, errorf, difff)
+			canRepeat, quantified = true, false
+		case '[':
+			i = scanJavaClass(r, i, errorf, difff)
+			canRepeat, quantified = true, false
+		case '(':
+			i = scanJavaGroup(r, i, errorf, difff)
+			groups = append(groups, i)
+			canRepeat, quantified = false, false
+		case ')':
+			if len(groups) == 0 {
+				errorf(i, "unmatched closing ')'")
+			} else {
+				groups = groups[:len(groups)-1]
+			}
+			canRepeat, quantified = true, false
+		case '|':
+			canRepeat, quantified = false, false
+		case '^', '$':
+			canRepeat, quantified = true, false
+		case '*', '+', '?', '{':
+			if c == '{' {
+				end, min, max, ok := scanRepetition(r, i)
+				if !ok {
+					errorf(i, "illegal repetition, use \\{ to match a literal '{'")
+					canRepeat = true
+					continue
+				}
+				if max >= 0 && min > max {
+					errorf(i, "illegal repetition range {%d,%d}", min, max)
+				}
+				if min > 1000 || max > 1000 {
+					difff(i, "repetition count above 1000 is not supported by Go")
+				}
+				i = end
+			}
+			if quantified && (c == '+' || c == '?') {
+				if c == '+' {
+					difff(i, "possessive quantifier is not supported by Go")
+				}
+				quantified = false
+				continue
+			}
+			if !canRepeat {
+				errorf(i, "dangling meta character '%c'", c)
+			}
+			canRepeat, quantified = false, true
+		default:
+			canRepeat, quantified = true, false
+		}
+	}
+	if len(groups) > 0 {
+		errorf(len(r), "unclosed group")
+	}
+	return errs, diffs
+}
+
+// scanRepetition scans a "{n}", "{n,}" or "{n,m}" repetition starting at i, returning the
+// index of the closing brace and the bounds, max being -1 when unbounded.
+func scanRepetition(r []rune, i int) (end, min, max int, ok bool) {
+	j := i + 1
+	for j < len(r) && r[j] != '}' {
+		j++
+	}
+	if j >= len(r) {
+		return 0, 0, 0, false
+	}
+	parts := strings.Split(string(r[i+1:j]), ",")
+	if len(parts) > 2 || parts[0] == "" {
+		return 0, 0, 0, false
+	}
+	min, err := strconv.Atoi(parts[0])
+	if err != nil || min < 0 {
+		return 0, 0, 0, false
+	}
+	max = min
+	if len(parts) == 2 {
+		max = -1
+		if parts[1] != "" {
+			if max, err = strconv.Atoi(parts[1]); err != nil || max < 0 {
+				return 0, 0, 0, false
+			}
+		}
+	}
+	return j, min, max, true
+}
+
+// scanJavaEscape scans the escape sequence starting at i, returning the index of its last rune.
+func scanJavaEscape(r []rune, i int, inClass bool, errorf, difff func(int, string, ...interface{})) int {
+	if i+1 >= len(r) {
+		errorf(i, "unexpected trailing '\\'")
+		return i
+	}
+	start := i
+	i++
+	c := r[i]
+	hex := func(n int) bool {
+		if i+n >= len(r) {
+			return false
+		}
+		for _, h := range r[i+1 : i+1+n] {
+			if !strings.ContainsRune("0123456789abcdefABCDEF", h) {
+				return false
+			}
+		}
+		return true
+	}
+	switch {
+	case c >= '1' && c <= '9':
+		if inClass {
+			errorf(start, "illegal escape sequence \\%c in character class", c)
+			return i
+		}
+		difff(start, "backreference \\%c is not supported by Go", c)
+	case c == '0':
+		if i+1 >= len(r) || r[i+1] < '0' || r[i+1] > '7' {
+			errorf(start, "illegal octal escape sequence")
+			return i
+		}
+		for n := 0; n < 3 && i+1 < len(r) && r[i+1] >= '0' && r[i+1] <= '7'; n++ {
+			i++
+		}
+	case c == 'Q':
+		end := strings.Index(string(r[i+1:]), `\E`)
+		if end < 0 {
+			return len(r) - 1
+		}
+		return i + len([]rune(string(r[i+1:])[:end])) + 2
+	case c == 'x':
+		if i+1 < len(r) && r[i+1] == '{' {
+			end := strings.IndexRune(string(r[i+1:]), '}')
+			if end < 0 {
+				errorf(start, "unclosed hexadecimal escape sequence")
+				return len(r) - 1
+			}
+			digits := string(r[i+1:])[1:end]
+			if v, err := strconv.ParseUint(digits, 16, 32); err != nil || v > unicode.MaxRune {
+				errorf(start, "illegal hexadecimal escape sequence")
+			}
+			return i + len([]rune(string(r[i+1:])[:end])) + 1
+		}
+		if !hex(2) {
+			errorf(start, "illegal hexadecimal escape sequence")
+			return i
+		}
+		return i + 2
+	case c == 'u':
+		if !hex(4) {
+			errorf(start, "illegal unicode escape sequence")
+			return i
+		}
+		difff(start, "unicode escape \\u is not supported by Go, use \\x{...}")
+		return i + 4
+	case c == 'c':
+		if i+1 >= len(r) {
+			errorf(start, "illegal control escape sequence")
+			return i
+		}
+		difff(start, "control escape \\c is not supported by Go")
+		return i + 1
+	case c == 'p' || c == 'P':
+		return scanJavaProperty(r, start, errorf, difff)
+	case c == 'k':
+		end := strings.IndexRune(string(r[i+1:]), '>')
+		if i+1 >= len(r) || r[i+1] != '<' || end < 0 || !validJavaGroupName(string(r[i+1:])[1:end]) {
+			errorf(start, "named backreference must be \\k<name>")
+			return i
+		}
+		difff(start, "named backreference \\k is not supported by Go")
+		return i + len([]rune(string(r[i+1:])[:end])) + 1
+	case c == 'v':
+		difff(start, "\\v matches any vertical whitespace in Java but only a vertical tab in Go")
+	case strings.ContainsRune("eGZhHRXN", c):
+		difff(start, "escape sequence \\%c is not supported by Go", c)
+	case strings.ContainsRune("tnrfadDsSwWbBAzE", c):
+	case c < unicode.MaxASCII && (unicode.IsLetter(c) || unicode.IsDigit(c)):
+		errorf(start, "illegal escape sequence \\%c", c)
+	case c > unicode.MaxASCII:
+		difff(start, "escaping non-ASCII character %q is not supported by Go", c)
+	}
+	return i
+}
+
+// scanJavaProperty scans a `\p` or `\P` character property starting at i, returning the index
+// of its last rune.
+func scanJavaProperty(r []rune, i int, errorf, difff func(int, string, ...interface{})) int {
+	start := i
+	i += 2
+	if i >= len(r) {
+		errorf(start, "illegal character property")
+		return len(r) - 1
+	}
+	name := string(r[i])
+	if r[i] == '{' {
+		end := strings.IndexRune(string(r[i:]), '}')
+		if end < 0 {
+			errorf(start, "unclosed character property")
+			return len(r) - 1
+		}
+		name = string(r[i:])[1:end]
+		i += len([]rune(string(r[i:])[:end]))
+	}
+	_, category := unicode.Categories[name]
+	_, script := unicode.Scripts[name]
+	switch {
+	case strings.HasPrefix(name, "^"):
+		errorf(start, "negated character property \\p{^...} is not supported by Java, use \\P{...}")
+	case strings.HasPrefix(name, "In") || strings.HasPrefix(name, "Is") || strings.Contains(name, "="):
+		difff(start, "character property %s is not supported by Go", name)
+	case category:
+	case javaPropertyNames[name]:
+		difff(start, "character property %s is not supported by Go", name)
+	case script || name == "Any":
+		errorf(start, "unknown character property %s, use \\p{Is%s}", name, name)
+	default:
+		errorf(start, "unknown character property %s", name)
+	}
+	return i
+}
+
+// scanJavaClass scans the character class starting at i, returning the index of the closing
+// bracket.
+func scanJavaClass(r []rune, i int, errorf, difff func(int, string, ...interface{})) int {
+	start := i
+	depth := 0
+	for ; i < len(r); i++ {
+		c := r[i]
+		switch {
+		case c == '\\':
+			i = scanJavaEscape(r, i, true, errorf, difff)
+			continue
+		case c == '[':
+			if i+1 < len(r) && r[i+1] == ':' {
+				if end := strings.Index(string(r[i:]), ":]"); end > 0 {
+					errorf(i, "POSIX class %s is not supported by Java, use \\p{...}", string(r[i:])[:end+2])
+				}
+			}
+			if depth > 0 {
+				difff(i, "nested character class is not supported by Go")
+			}
+			depth++
+			if i+1 < len(r) && r[i+1] == '^' {
+				i++
+			}
+			// a closing bracket right after the opening bracket is literal.
+			if i+1 < len(r) && r[i+1] == ']' {
+				i++
+			}
+		case c == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		case c == '&' && i+1 < len(r) && r[i+1] == '&':
+			difff(i, "character class intersection && is not supported by Go")
+			i++
+		}
+	}
+	errorf(start, "unclosed character class")
+	return len(r) - 1
+}
+
+// scanJavaGroup scans the opening of the group starting at i, returning the index of its last
+// rune.
+func scanJavaGroup(r []rune, i int, errorf, difff func(int, string, ...interface{})) int {
+	start := i
+	if i+1 >= len(r) || r[i+1] != '?' {
+		return i
+	}
+	i += 2
+	if i >= len(r) {
+		errorf(start, "unknown group construct")
+		return len(r) - 1
+	}
+	rest := string(r[i:])
+	switch {
+	case strings.HasPrefix(rest, ":"):
+		return i
+	case strings.HasPrefix(rest, "="), strings.HasPrefix(rest, "!"):
+		difff(start, "lookahead is not supported by Go")
+		return i
+	case strings.HasPrefix(rest, "<="), strings.HasPrefix(rest, "<!"):
+		difff(start, "lookbehind is not supported by Go")
+		return i + 1
+	case strings.HasPrefix(rest, ">"):
+		difff(start, "atomic group is not supported by Go")
+		return i
+	case strings.HasPrefix(rest, "P<"):
+		errorf(start, "named group (?P<name>...) is not supported by Java, use (?<name>...)")
+		if end := strings.IndexRune(rest, '>'); end > 0 {
+			return i + len([]rune(rest[:end]))
+		}
+		return i + 1
+	case strings.HasPrefix(rest, "<"):
+		end := strings.IndexRune(rest, '>')
+		if end < 0 {
+			errorf(start, "unclosed group name")
+			return len(r) - 1
+		}
+		if name := rest[1:end]; !validJavaGroupName(name) {
+			errorf(start, "invalid group name %q, Java only allows ASCII letters and digits", name)
+		}
+		difff(start, "named group (?<name>...) is not supported by Go, use (?P<name>...)")
+		return i + len([]rune(rest[:end]))
+	}
+	// inline flags, eg "(?i)" or "(?i-s:...)".
+	for ; i < len(r); i++ {
+		c := r[i]
+		switch {
+		case c == ')' || c == ':':
+			if c == ')' {
+				// an inline flags group is closed right away, it is not pushed as a group.
+				return i - 1
+			}
+			return i
+		case c == '-' || c == 'i' || c == 'm' || c == 's':
+		case c == 'U':
+			difff(i, "inline flag U enables unicode character classes in Java but ungreedy matching in Go")
+		case c == 'd' || c == 'u' || c == 'x':
+			difff(i, "inline flag %c is not supported by Go", c)
+		default:
+			errorf(i, "unknown inline flag %c", c)
+		}
+	}
+	errorf(start, "unclosed group")
+	return len(r) - 1
+}
+
+// validJavaGroupName returns whether name is a valid Java group name, an ASCII letter followed
+// by ASCII letters and digits.
+func validJavaGroupName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, c := range name {
+		letter := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		if !letter && (i == 0 || c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateLuceneRegex validates a Lucene regular expression, as compiled by Elasticsearch for
+// the `simple_pattern` and `simple_pattern_split` tokenizers. Lucene regular expressions always
+// match whole tokens and do not support escapes like `\b` or `\p`, lazy quantifiers or `(?...)`
+// groups, which are reported as errors since they would silently match literal characters or
+// fail to compile. Anchors and the `\d`, `\s` and `\w` character classes are accepted, see
+// LuceneRegexWarnings.
+//
+// See http://lucene.apache.org/core/8_3_0/core/org/apache/lucene/util/automaton/RegExp.html
+// for details.
+func ValidateLuceneRegex(pattern string) error {
+	r := []rune(pattern)
+	var (
+		errs       []string
+		groups     int
+		canRepeat  bool
+		quantified bool
+	)
+	errorf := func(i int, format string, args ...interface{}) {
+		errs = append(errs, fmt.Sprintf("at %d: ", i)+fmt.Sprintf(format, args...))
+	}
+	for i := 0; i < len(r); i++ {
+		c := r[i]
+		switch c {
+		case '\\':
+			if i+1 >= len(r) {
+				errorf(i, "unexpected trailing '\\'")
+				break
+			}
+			i++
+			if strings.ContainsRune("bBpPntrfvxu", r[i]) {
+				errorf(i-1, "escape \\%c matches a literal '%c' in Lucene", r[i], r[i])
+			}
+			canRepeat, quantified = true, false
+		case '[':
+			start := i
+			for i++; i < len(r) && r[i] != ']'; i++ {
+				if r[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(r) {
+				errorf(start, "unclosed character class")
+			}
+			canRepeat, quantified = true, false
+		case '"':
+			end := strings.IndexRune(string(r[i+1:]), '"')
+			if end < 0 {
+				errorf(i, "unclosed quoted string")
+				i = len(r)
+				break
+			}
+			i += len([]rune(string(r[i+1:])[:end])) + 1
+			canRepeat, quantified = true, false
+		case '<':
+			end := strings.IndexRune(string(r[i+1:]), '>')
+			interval := ""
+			if end >= 0 {
+				interval = string(r[i+1:])[:end]
+			}
+			bounds := strings.Split(interval, "-")
+			_, errMin := strconv.Atoi(bounds[0])
+			if len(bounds) != 2 || errMin != nil {
+				errorf(i, "illegal numeric interval, use \\< to match a literal '<'")
+			} else if _, errMax := strconv.Atoi(bounds[1]); errMax != nil {
+				errorf(i, "illegal numeric interval, use \\< to match a literal '<'")
+			}
+			if end >= 0 {
+				i += len([]rune(interval)) + 1
+			}
+			canRepeat, quantified = true, false
+		case '(':
+			if i+1 < len(r) && r[i+1] == '?' {
+				errorf(i, "(? group constructs are not supported by Lucene")
+				i++
+			}
+			groups++
+			canRepeat, quantified = false, false
+		case ')':
+			if groups == 0 {
+				errorf(i, "unmatched closing ')'")
+			} else {
+				groups--
+			}
+			canRepeat, quantified = true, false
+		case '|', '&':
+			canRepeat, quantified = false, false
+		case '*', '+', '?', '{':
+			if c == '{' {
+				end, min, max, ok := scanRepetition(r, i)
+				if !ok {
+					errorf(i, "illegal repetition, use \\{ to match a literal '{'")
+					canRepeat = true
+					continue
+				}
+				if max >= 0 && min > max {
+					errorf(i, "illegal repetition range {%d,%d}", min, max)
+				}
+				i = end
+			}
+			if quantified && (c == '+' || c == '?') {
+				errorf(i, "lazy and possessive quantifiers are not supported by Lucene")
+			} else if !canRepeat {
+				errorf(i, "dangling meta character '%c'", c)
+			}
+			canRepeat, quantified = true, true
+		default:
+			canRepeat, quantified = true, false
+		}
+	}
+	if groups > 0 {
+		errorf(len(r), "unclosed group")
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid lucene regex %q: %v", pattern, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// LuceneRegexWarnings returns warnings for a valid Lucene regular expression which may not
+// behave as expected: `^` and `$` match literal characters since patterns always match whole
+// tokens, and the `\d`, `\s` and `\w` character classes and their negations are only supported
+// since Lucene 8.6, ie Elasticsearch 7.9, and match literal characters in older versions.
+func LuceneRegexWarnings(pattern string) []string {
+	r := []rune(pattern)
+	var warnings []string
+	warnf := func(i int, format string, args ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf("at %d: ", i)+fmt.Sprintf(format, args...))
+	}
+	for i := 0; i < len(r); i++ {
+		switch c := r[i]; c {
+		case '\\':
+			if i+1 < len(r) && strings.ContainsRune("dDwWsS", r[i+1]) {
+				warnf(i, "escape \\%c requires Elasticsearch 7.9 or later", r[i+1])
+			}
+			i++
+		case '[':
+			for i++; i < len(r) && r[i] != ']'; i++ {
+				if r[i] == '\\' {
+					i++
+				}
+			}
+		case '"':
+			end := strings.IndexRune(string(r[i+1:]), '"')
+			if end < 0 {
+				return warnings
+			}
+			i += len([]rune(string(r[i+1:])[:end])) + 1
+		case '^', '$':
+			warnf(i, "'%c' matches a literal '%c' in Lucene, patterns always match whole tokens", c, c)
+		}
+	}
+	return warnings
+}
+
+// ValidateRegexes validates the regular expressions and flags of the pattern based analysis
+// components and the `regex` dynamic templates of the index. Patterns longer than
+// `index.max_regex_length` are reported as well.
+// Defaults to a maximum length of 1000.
+func (i *Index) ValidateRegexes() error {
+	maxLength := 1000
+	if i.maxRegexLength != nil {
+		maxLength = *i.maxRegexLength
+	}
+	var invalid []string
+	check := func(component string, lucene bool, pattern string, flags ...string) {
+		if pattern == "" {
+			return
+		}
+		var err error
+		if lucene {
+			err = ValidateLuceneRegex(pattern)
+		} else {
+			err = ValidateJavaRegex(pattern, flags...)
+		}
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %v", component, err))
+		}
+		if length := len([]rune(pattern)); length > maxLength {
+			invalid = append(invalid, fmt.Sprintf("%s: regex length %d exceeds max_regex_length %d", component, length, maxLength))
+		}
+	}
+
+	if a := i.analysis; a != nil {
+		analyzers := a.analyzer
+		if a.defaultAnalyzer != nil {
+			analyzers = append([]Analyzer{a.defaultAnalyzer}, analyzers...)
+		}
+		for _, _a := range analyzers {
+			if t, ok := _a.(*AnalyzerPattern); ok {
+				check("analyzer ["+t.name+"]", false, t.pattern, t.flags...)
+			}
+		}
+		for _, _t := range a.tokenizer {
+			switch t := _t.(type) {
+			case *TokenizerPattern:
+				check("tokenizer ["+t.name+"]", false, t.pattern, t.flags...)
+			case *TokenizerSimplePattern:
+				check("tokenizer ["+t.name+"]", true, t.pattern)
+			case *TokenizerSimplePatternSplit:
+				check("tokenizer ["+t.name+"]", true, t.pattern)
+			}
+		}
+		for _, f := range a.filter {
+			switch t := f.(type) {
+			case *TokenFilterPatternReplace:
+				check("filter ["+t.name+"]", false, t.pattern)
+			case *TokenFilterPatternCapture:
+				for _, p := range t.patterns {
+					check("filter ["+t.name+"]", false, p)
+				}
+			}
+		}
+		for _, f := range a.charFilter {
+			if t, ok := f.(*CharacterFilterPatternReplaceChar); ok {
+				check("char_filter ["+t.name+"]", false, t.pattern, t.flags...)
+			}
+		}
+	}
+	if m := i.mappings; m != nil {
+		for _, t := range m.dynamicTemplates {
+			if t.matchPattern != "regex" {
+				continue
+			}
+			for _, p := range []string{t.match, t.unmatch, t.pathMatch, t.pathUnmatch} {
+				check("dynamic_template ["+t.name+"]", false, p)
+			}
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid regexes: %v", invalid)
+	}
+	return nil
+}
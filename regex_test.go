@@ -0,0 +1,264 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateJavaRegex(t *testing.T) {
+	tests := []struct {
+		desc    string
+		pattern string
+		flags   []string
+		valid   bool
+		diffs   int
+	}{
+		// #0
+		{
+			desc:    "Plain pattern.",
+			pattern: `\W+`,
+			valid:   true,
+		},
+		// #1
+		{
+			desc:    "Lookahead, backreference and possessive quantifier.",
+			pattern: `(?=\d)(\w)\1++`,
+			valid:   true,
+			diffs:   3,
+		},
+		// #2
+		{
+			desc:    "Java named group and script property.",
+			pattern: `(?<year>\d{4})\p{IsLatin}`,
+			valid:   true,
+			diffs:   2,
+		},
+		// #3
+		{
+			desc:    "Go named group.",
+			pattern: `(?P<year>\d{4})`,
+			valid:   false,
+		},
+		// #4
+		{
+			desc:    "Group name with underscore.",
+			pattern: `(?<my_year>\d{4})`,
+			valid:   false,
+		},
+		// #5
+		{
+			desc:    "POSIX class.",
+			pattern: `[[:alpha:]]+`,
+			valid:   false,
+		},
+		// #6
+		{
+			desc:    "Go script property without Is prefix.",
+			pattern: `\p{Greek}+`,
+			valid:   false,
+		},
+		// #7
+		{
+			desc:    "Literal brace accepted by Go only.",
+			pattern: `a{,3}`,
+			valid:   false,
+		},
+		// #8
+		{
+			desc:    "Unknown escape sequence.",
+			pattern: `\y`,
+			valid:   false,
+		},
+		// #9
+		{
+			desc:    "Unbalanced groups.",
+			pattern: `(a|b`,
+			valid:   false,
+		},
+		// #10
+		{
+			desc:    "Dangling quantifier.",
+			pattern: `*a`,
+			valid:   false,
+		},
+		// #11
+		{
+			desc:    "Quoted and comments.",
+			pattern: "\\Q(*\\E  # comment\n [a-z&&[^aeiou]]",
+			flags:   []string{"COMMENTS|CASE_INSENSITIVE"},
+			valid:   true,
+			diffs:   2,
+		},
+		// #12
+		{
+			desc:    "Literal flag.",
+			pattern: `(*`,
+			flags:   []string{"LITERAL"},
+			valid:   true,
+		},
+		// #13
+		{
+			desc:    "Unknown flag.",
+			pattern: `\W+`,
+			flags:   []string{"CASE_INSENSITIVE|IGNORE_CASE"},
+			valid:   false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := ValidateJavaRegex(test.pattern, test.flags...)
+			if test.valid && err != nil {
+				t.Errorf("expected valid, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected error, got nil")
+			}
+			if test.valid {
+				if diffs := JavaRegexDifferences(test.pattern, test.flags...); len(diffs) != test.diffs {
+					t.Errorf("expected %d differences, got: %v", test.diffs, diffs)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateLuceneRegex(t *testing.T) {
+	tests := []struct {
+		desc    string
+		pattern string
+		valid   bool
+	}{
+		// #0
+		{
+			desc:    "Character class with repetition.",
+			pattern: `[0123456789]{3}`,
+			valid:   true,
+		},
+		// #1
+		{
+			desc:    "Quoted string, numeric interval and union.",
+			pattern: `"id-"<1-100>|[a-z]+`,
+			valid:   true,
+		},
+		// #2
+		{
+			desc:    "Character class escape.",
+			pattern: `\d+`,
+			valid:   true,
+		},
+		// #3
+		{
+			desc:    "Anchors.",
+			pattern: `^[a-z]+$`,
+			valid:   true,
+		},
+		// #4
+		{
+			desc:    "Non-capturing group.",
+			pattern: `(?:ab)+`,
+			valid:   false,
+		},
+		// #5
+		{
+			desc:    "Lazy quantifier.",
+			pattern: `a+?`,
+			valid:   false,
+		},
+		// #6
+		{
+			desc:    "Unclosed character class.",
+			pattern: `[a-z`,
+			valid:   false,
+		},
+		// #7
+		{
+			desc:    "Unsupported escape.",
+			pattern: `\bfoo`,
+			valid:   false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := ValidateLuceneRegex(test.pattern)
+			if test.valid && err != nil {
+				t.Errorf("expected valid, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestLuceneRegexWarnings(t *testing.T) {
+	tests := []struct {
+		desc     string
+		pattern  string
+		expected []string
+	}{
+		// #0
+		{
+			desc:    "Character classes and quoted string.",
+			pattern: `[^0-9]+"$"`,
+		},
+		// #1
+		{
+			desc:    "Anchors and character class escapes.",
+			pattern: `^\d+\.\w*$`,
+			expected: []string{
+				"at 0: '^' matches a literal '^' in Lucene, patterns always match whole tokens",
+				"at 1: escape \\d requires Elasticsearch 7.9 or later",
+				"at 6: escape \\w requires Elasticsearch 7.9 or later",
+				"at 9: '$' matches a literal '$' in Lucene, patterns always match whole tokens",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got := LuceneRegexWarnings(test.pattern)
+			if strings.Join(got, "\n") != strings.Join(test.expected, "\n") {
+				t.Errorf("expected\n%v\n,got:\n%v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestIndexValidateRegexes(t *testing.T) {
+	i := NewIndex().MaxRegexLength(10).Analysis(
+		NewAnalysis().
+			Tokenizer(
+				NewTokenizerPattern("valid_pattern").Pattern(`,`),
+				NewTokenizerSimplePattern("invalid_simple_pattern").Pattern(`(?:\d)+`),
+			).
+			Filter(NewTokenFilterPatternCapture("invalid_capture").Patterns(`(\w+)`, `(?P<num>\d+)`)).
+			CharFilter(NewCharacterFilterPatternReplaceChar("long_pattern").Pattern(`(\d+)-(?=\d)+x`)),
+	).Mappings(
+		NewMappings().DynamicTemplates(
+			NewDynamicTemplate("invalid_template").MatchPattern("regex").Match(`[[:digit:]]`),
+		),
+	)
+	err := i.ValidateRegexes()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	for _, expected := range []string{
+		"tokenizer [invalid_simple_pattern]",
+		"filter [invalid_capture]",
+		"char_filter [long_pattern]: regex length 14 exceeds max_regex_length 10",
+		"dynamic_template [invalid_template]",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to contain %q, got: %v", expected, err)
+		}
+	}
+	if strings.Contains(err.Error(), "valid_pattern]") {
+		t.Errorf("expected valid_pattern to be valid, got: %v", err)
+	}
+	if err := NewTokenizerPattern("test").Pattern(`\W+`).Flags("UNKNOWN").Validate(true); err == nil {
+		t.Error("expected TokenizerPattern validation error, got nil")
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RuntimeField field that is evaluated at query time, defined in the `runtime` section of the
+// mappings. Its value is computed by a Painless script, or loaded from the `_source` field with
+// the same name when no script is set. Requires Elasticsearch 7.11 or later.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.11/runtime.html
+// for details.
+type RuntimeField struct {
+	name   string
+	typ    string
+	script *Script
+	format []*DateFormat
+	fields []*RuntimeField
+}
+
+// NewRuntimeField initializes a new RuntimeField, typ can be set to "keyword", "long",
+// "double", "date", "ip", "boolean", "geo_point" or "composite".
+func NewRuntimeField(name, typ string) *RuntimeField {
+	return &RuntimeField{
+		name:   name,
+		typ:    typ,
+		format: make([]*DateFormat, 0),
+		fields: make([]*RuntimeField, 0),
+	}
+}
+
+// Name returns field key for the RuntimeField.
+func (f *RuntimeField) Name() string {
+	return f.name
+}
+
+// Script sets the Painless script computing the field values through `emit`. Required for
+// composite runtime fields.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.11/runtime-mapping-fields.html
+// for details.
+func (f *RuntimeField) Script(script *Script) *RuntimeField {
+	f.script = script
+	return f
+}
+
+// Format sets date format for Elasticsearch to parse and format the values of date runtime
+// fields.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.11/mapping-date-format.html
+// for details.
+func (f *RuntimeField) Format(format ...*DateFormat) *RuntimeField {
+	f.format = append(f.format, format...)
+	return f
+}
+
+// Fields sets the sub-fields of a composite runtime field, which emits a map of values, one
+// for each sub-field, from a single script. Sub-fields are defined by their type only.
+// Requires Elasticsearch 8.2 or later.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.2/runtime-mapping-fields.html#runtime-field-composite
+// for details.
+func (f *RuntimeField) Fields(fields ...*RuntimeField) *RuntimeField {
+	f.fields = append(f.fields, fields...)
+	return f
+}
+
+// Validate validates RuntimeField.
+func (f *RuntimeField) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && f.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if valid := map[string]bool{
+		"keyword":   true,
+		"long":      true,
+		"double":    true,
+		"date":      true,
+		"ip":        true,
+		"boolean":   true,
+		"geo_point": true,
+		"composite": true,
+	}[f.typ]; !valid {
+		invalid = append(invalid, "Type")
+	}
+	if f.script != nil {
+		if err := f.script.Validate(); err != nil {
+			invalid = append(invalid, "Script")
+		}
+	}
+	if len(f.format) > 0 && f.typ != "date" {
+		invalid = append(invalid, "Format")
+	}
+	if f.typ == "composite" {
+		if f.script == nil {
+			invalid = append(invalid, "Script")
+		}
+		if len(f.fields) == 0 {
+			invalid = append(invalid, "Fields")
+		}
+		// sub-fields are emitted by the composite script and cannot be composite themselves.
+		for _, sub := range f.fields {
+			if err := sub.Validate(true); err != nil || sub.typ == "composite" || sub.script != nil {
+				invalid = append(invalid, "Fields")
+				break
+			}
+		}
+	} else if len(f.fields) > 0 {
+		invalid = append(invalid, "Fields")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (f *RuntimeField) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "composite",
+	// 		"script": {
+	// 			"source": "emit(grok('%{COMMONAPACHELOG}').extract(doc['message'].value))"
+	// 		},
+	// 		"format": "strict_date_optional_time||epoch_millis",
+	// 		"fields": {
+	// 			"clientip": {
+	// 				"type": "ip"
+	// 			}
+	// 		}
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = f.typ
+
+	if f.script != nil {
+		script, err := f.script.Source(false)
+		if err != nil {
+			return nil, err
+		}
+		options["script"] = script
+	}
+	if len(f.format) > 0 {
+		formats := make([]string, 0)
+		for _, df := range f.format {
+			format, err := df.Source()
+			if err != nil {
+				return nil, err
+			}
+			formats = append(formats, fmt.Sprintf("%s", format))
+		}
+		options["format"] = strings.Join(formats, "||")
+	}
+	if len(f.fields) > 0 {
+		fields := make(map[string]interface{})
+		for _, sub := range f.fields {
+			field, err := sub.Source(false)
+			if err != nil {
+				return nil, err
+			}
+			fields[sub.name] = field
+		}
+		options["fields"] = fields
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[f.name] = options
+	return source, nil
+}
+
+// runtimeShadowTypes field datatypes which a runtime field can shadow without changing the
+// semantics of queries on the field, by runtime field type.
+var runtimeShadowTypes = map[string]map[string]bool{
+	"keyword": {
+		"keyword": true, "constant_keyword": true, "wildcard": true, "text": true,
+		"match_only_text": true, "version": true, "icu_collation_keyword": true,
+	},
+	"long": {
+		"long": true, "integer": true, "short": true, "byte": true, "unsigned_long": true, "token_count": true,
+	},
+	"double": {
+		"double": true, "float": true, "half_float": true, "scaled_float": true,
+		"long": true, "integer": true, "short": true, "byte": true, "unsigned_long": true,
+	},
+	"date":      {"date": true, "date_nanos": true},
+	"ip":        {"ip": true},
+	"boolean":   {"boolean": true},
+	"geo_point": {"geo_point": true},
+}
+
+// ValidateRuntimeFields validates the runtime fields of the mappings, and that runtime fields,
+// including the sub-fields of composite runtime fields, do not shadow indexed fields with an
+// incompatible type, eg a `long` runtime field shadowing a `keyword` field, as queries on the
+// field would then be executed against a different type than the indexed one.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.11/runtime-override-values.html
+// for details.
+func (m *Mappings) ValidateRuntimeFields() error {
+	indexed := make(map[string]string)
+	walkDatatypes("", m.properties, func(path string, d Datatype) {
+		indexed[path] = componentType(d)
+	})
+
+	var invalid []string
+	shadow := func(path, typ string) {
+		fieldType, exists := indexed[path]
+		if !exists || runtimeShadowTypes[typ][fieldType] {
+			return
+		}
+		invalid = append(invalid, fmt.Sprintf("runtime field [%s] of type [%s] shadows field [%s] "+
+			"of incompatible type [%s]", path, typ, path, fieldType))
+	}
+	for _, f := range m.runtime {
+		if err := f.Validate(true); err != nil {
+			invalid = append(invalid, fmt.Sprintf("runtime field [%s]: %v", f.name, err))
+			continue
+		}
+		if f.typ != "composite" {
+			shadow(f.name, f.typ)
+			continue
+		}
+		for _, sub := range f.fields {
+			shadow(f.name+"."+sub.name, sub.typ)
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid runtime fields: %v", invalid)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRuntimeFieldSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		f           *RuntimeField
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name with Script.",
+			f:           NewRuntimeField("day_of_week", "keyword").Script(NewScript("emit(doc['@timestamp'].value.dayOfWeekEnum.toString())")),
+			includeName: true,
+			expected:    `{"day_of_week":{"script":{"source":"emit(doc['@timestamp'].value.dayOfWeekEnum.toString())"},"type":"keyword"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with Format.",
+			f:           NewRuntimeField("timestamp", "date").Format(NewDateFormat("date_optional_time").Strict(true), NewDateFormat("epoch_millis")),
+			includeName: false,
+			expected:    `{"format":"strict_date_optional_time||epoch_millis","type":"date"}`,
+		},
+		// #2
+		{
+			desc: "Exclude Name with composite Fields.",
+			f: NewRuntimeField("http", "composite").
+				Script(NewScript("emit(grok('%{COMMONAPACHELOG}').extract(doc['message'].value))")).
+				Fields(NewRuntimeField("clientip", "ip"), NewRuntimeField("verb", "keyword")),
+			includeName: false,
+			expected:    `{"fields":{"clientip":{"type":"ip"},"verb":{"type":"keyword"}},"script":{"source":"emit(grok('%{COMMONAPACHELOG}').extract(doc['message'].value))"},"type":"composite"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.f.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestRuntimeFieldValidation(t *testing.T) {
+	tests := []struct {
+		desc  string
+		f     *RuntimeField
+		valid bool
+	}{
+		// #0
+		{
+			desc:  "Runtime field loaded from _source.",
+			f:     NewRuntimeField("test", "long"),
+			valid: true,
+		},
+		// #1
+		{
+			desc:  "Invalid type.",
+			f:     NewRuntimeField("test", "text"),
+			valid: false,
+		},
+		// #2
+		{
+			desc:  "Format on keyword.",
+			f:     NewRuntimeField("test", "keyword").Format(NewDateFormat("epoch_millis")),
+			valid: false,
+		},
+		// #3
+		{
+			desc:  "Composite without script.",
+			f:     NewRuntimeField("test", "composite").Fields(NewRuntimeField("ip", "ip")),
+			valid: false,
+		},
+		// #4
+		{
+			desc:  "Composite sub-field with script.",
+			f:     NewRuntimeField("test", "composite").Script(NewScript("emit(params._source)")).Fields(NewRuntimeField("ip", "ip").Script(NewScript("emit('')"))),
+			valid: false,
+		},
+		// #5
+		{
+			desc:  "Fields on non composite.",
+			f:     NewRuntimeField("test", "keyword").Fields(NewRuntimeField("ip", "ip")),
+			valid: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.f.Validate(true)
+			if test.valid && err != nil {
+				t.Errorf("expected no error, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestMappingsValidateRuntimeFields(t *testing.T) {
+	m := NewMappings().
+		Properties(
+			NewDatatypeInteger("status"),
+			NewDatatypeKeyword("duration"),
+			NewDatatypeObject("http").Properties(NewDatatypeKeyword("clientip")),
+		).
+		Runtime(
+			NewRuntimeField("status", "long"),
+			NewRuntimeField("duration", "double").Script(NewScript("emit(Double.parseDouble(doc['duration'].value))")),
+			NewRuntimeField("http", "composite").Script(NewScript("emit(params._source.http)")).Fields(NewRuntimeField("clientip", "ip")),
+		)
+	err := m.Validate()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	for _, expected := range []string{
+		"runtime field [duration] of type [double] shadows field [duration] of incompatible type [keyword]",
+		"runtime field [http.clientip] of type [ip] shadows field [http.clientip] of incompatible type [keyword]",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to contain %q, got: %v", expected, err)
+		}
+	}
+	if strings.Contains(err.Error(), "[status]") {
+		t.Errorf("expected long runtime field to shadow integer field, got: %v", err)
+	}
+}
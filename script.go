@@ -76,14 +76,14 @@ func (s *Script) Validate() error {
 	if s.source == "" && s.id == "" {
 		invalid = append(invalid, "Source || ID")
 	}
-	if s.source != "" {
+	if s.lang != "" {
 		if _, valid := map[string]bool{
 			"painless":   true,
 			"expression": true,
 			"mustache":   true,
 			"java":       true,
-		}[s.source]; !valid {
-			invalid = append(invalid, "Source")
+		}[s.lang]; !valid {
+			invalid = append(invalid, "Lang")
 		}
 	}
 	if len(invalid) > 0 {
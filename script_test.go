@@ -46,4 +46,10 @@ func TestScriptSerialization(t *testing.T) {
 			}
 		})
 	}
+	if err := NewScript("emit(doc['field'].value)").Validate(); err != nil {
+		t.Errorf("expected no Script validation error, got: %v", err)
+	}
+	if err := NewScript("emit(doc['field'].value)").Lang("python").Validate(); err == nil {
+		t.Error("expected Script validation error, got nil")
+	}
 }
@@ -0,0 +1,91 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// stemming stemmer algorithms available for a language, as `stemmer` token filter languages.
+type stemming struct {
+	aggressive string
+	light      string
+	// preferLight whether Elasticsearch recommends the light stemmer for the language.
+	preferLight bool
+}
+
+// stemmingMatrix stemmer algorithms available for each language with a built-in language
+// analyzer. Languages without a stemmer, eg "cjk", "persian" and "thai", are not listed.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-stemmer-tokenfilter.html
+// for details.
+var stemmingMatrix = map[Language]stemming{
+	LanguageArabic:     {aggressive: "arabic"},
+	LanguageArmenian:   {aggressive: "armenian"},
+	LanguageBasque:     {aggressive: "basque"},
+	LanguageBengali:    {aggressive: "bengali", light: "light_bengali"},
+	LanguageBrazilian:  {aggressive: "brazilian"},
+	LanguageBulgarian:  {aggressive: "bulgarian"},
+	LanguageCatalan:    {aggressive: "catalan"},
+	LanguageCzech:      {aggressive: "czech"},
+	LanguageDanish:     {aggressive: "danish"},
+	LanguageDutch:      {aggressive: "dutch"},
+	LanguageEnglish:    {aggressive: "english", light: "light_english"},
+	LanguageEstonian:   {aggressive: "estonian"},
+	LanguageFinnish:    {aggressive: "finnish", light: "light_finnish"},
+	LanguageFrench:     {aggressive: "french", light: "light_french", preferLight: true},
+	LanguageGalician:   {aggressive: "galician", light: "minimal_galician"},
+	LanguageGerman:     {aggressive: "german", light: "light_german", preferLight: true},
+	LanguageGreek:      {aggressive: "greek"},
+	LanguageHindi:      {aggressive: "hindi"},
+	LanguageHungarian:  {aggressive: "hungarian", light: "light_hungarian"},
+	LanguageIndonesian: {aggressive: "indonesian"},
+	LanguageIrish:      {aggressive: "irish"},
+	LanguageItalian:    {aggressive: "italian", light: "light_italian", preferLight: true},
+	LanguageLatvian:    {aggressive: "latvian"},
+	LanguageLithuanian: {aggressive: "lithuanian"},
+	LanguageNorwegian:  {aggressive: "norwegian", light: "light_norwegian"},
+	LanguagePortuguese: {aggressive: "portuguese", light: "light_portuguese", preferLight: true},
+	LanguageRomanian:   {aggressive: "romanian"},
+	LanguageRussian:    {aggressive: "russian", light: "light_russian"},
+	LanguageSorani:     {aggressive: "sorani"},
+	LanguageSpanish:    {aggressive: "spanish", light: "light_spanish", preferLight: true},
+	LanguageSwedish:    {aggressive: "swedish", light: "light_swedish"},
+	LanguageTurkish:    {aggressive: "turkish"},
+}
+
+// RecommendedStemmer returns the `stemmer` token filter Elasticsearch recommends for the
+// language, which is the light stemmer for languages such as French, German, Italian,
+// Portuguese and Spanish, and the aggressive stemmer otherwise.
+func RecommendedStemmer(name string, language Language) (TokenFilter, error) {
+	s, ok := stemmingMatrix[language]
+	if !ok {
+		return nil, fmt.Errorf("no stemmer available for language: %s", language)
+	}
+	if s.preferLight {
+		return NewTokenFilterStemmer(name).Language(s.light), nil
+	}
+	return NewTokenFilterStemmer(name).Language(s.aggressive), nil
+}
+
+// AggressiveStemmer returns the aggressive (algorithmic) `stemmer` token filter for the language.
+func AggressiveStemmer(name string, language Language) (TokenFilter, error) {
+	s, ok := stemmingMatrix[language]
+	if !ok {
+		return nil, fmt.Errorf("no stemmer available for language: %s", language)
+	}
+	return NewTokenFilterStemmer(name).Language(s.aggressive), nil
+}
+
+// LightStemmer returns the light `stemmer` token filter for the language, falling back to the
+// aggressive stemmer when the language has no light variant.
+func LightStemmer(name string, language Language) (TokenFilter, error) {
+	s, ok := stemmingMatrix[language]
+	if !ok {
+		return nil, fmt.Errorf("no stemmer available for language: %s", language)
+	}
+	if s.light == "" {
+		return NewTokenFilterStemmer(name).Language(s.aggressive), nil
+	}
+	return NewTokenFilterStemmer(name).Language(s.light), nil
+}
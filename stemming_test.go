@@ -0,0 +1,87 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStemmingMatrix(t *testing.T) {
+	tests := []struct {
+		desc     string
+		stemmer  func(name string, language Language) (TokenFilter, error)
+		language Language
+		expected string
+	}{
+		// #0
+		{
+			desc:     "Recommended stemmer prefers light stemmer for German.",
+			stemmer:  RecommendedStemmer,
+			language: LanguageGerman,
+			expected: `{"test":{"language":"light_german","type":"stemmer"}}`,
+		},
+		// #1
+		{
+			desc:     "Recommended stemmer for English.",
+			stemmer:  RecommendedStemmer,
+			language: LanguageEnglish,
+			expected: `{"test":{"language":"english","type":"stemmer"}}`,
+		},
+		// #2
+		{
+			desc:     "Aggressive stemmer for Spanish.",
+			stemmer:  AggressiveStemmer,
+			language: LanguageSpanish,
+			expected: `{"test":{"language":"spanish","type":"stemmer"}}`,
+		},
+		// #3
+		{
+			desc:     "Light stemmer for Russian.",
+			stemmer:  LightStemmer,
+			language: LanguageRussian,
+			expected: `{"test":{"language":"light_russian","type":"stemmer"}}`,
+		},
+		// #4
+		{
+			desc:     "Light stemmer falls back to aggressive stemmer for Turkish.",
+			stemmer:  LightStemmer,
+			language: LanguageTurkish,
+			expected: `{"test":{"language":"turkish","type":"stemmer"}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			filter, err := test.stemmer("test", test.language)
+			if err != nil {
+				t.Fatal(err)
+			}
+			src, err := filter.Source(true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+	for language, s := range stemmingMatrix {
+		for _, l := range []string{s.aggressive, s.light} {
+			if l == "" {
+				continue
+			}
+			if err := NewTokenFilterStemmer("test").Language(l).Validate(true); err != nil {
+				t.Errorf("expected valid stemmer language %q for %s, got: %v", l, language, err)
+			}
+		}
+	}
+	if _, err := RecommendedStemmer("test", LanguageThai); err == nil {
+		t.Error("expected error for language without stemmer, got nil")
+	}
+}
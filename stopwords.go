@@ -0,0 +1,218 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Stopwords pre-defined stop words list name like "_english_" which can be used in the
+// `stopwords` parameter of the `stop` token filter, `stop` analyzer, `standard` analyzer and
+// language analyzers.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-stop-tokenfilter.html#analysis-stop-tokenfilter-stop-words-by-lang
+// for details.
+type Stopwords string
+
+// Pre-defined stop words lists.
+const (
+	StopwordsArabic     Stopwords = "_arabic_"
+	StopwordsArmenian   Stopwords = "_armenian_"
+	StopwordsBasque     Stopwords = "_basque_"
+	StopwordsBengali    Stopwords = "_bengali_"
+	StopwordsBrazilian  Stopwords = "_brazilian_"
+	StopwordsBulgarian  Stopwords = "_bulgarian_"
+	StopwordsCatalan    Stopwords = "_catalan_"
+	StopwordsCJK        Stopwords = "_cjk_"
+	StopwordsCzech      Stopwords = "_czech_"
+	StopwordsDanish     Stopwords = "_danish_"
+	StopwordsDutch      Stopwords = "_dutch_"
+	StopwordsEnglish    Stopwords = "_english_"
+	StopwordsEstonian   Stopwords = "_estonian_"
+	StopwordsFinnish    Stopwords = "_finnish_"
+	StopwordsFrench     Stopwords = "_french_"
+	StopwordsGalician   Stopwords = "_galician_"
+	StopwordsGerman     Stopwords = "_german_"
+	StopwordsGreek      Stopwords = "_greek_"
+	StopwordsHindi      Stopwords = "_hindi_"
+	StopwordsHungarian  Stopwords = "_hungarian_"
+	StopwordsIndonesian Stopwords = "_indonesian_"
+	StopwordsIrish      Stopwords = "_irish_"
+	StopwordsItalian    Stopwords = "_italian_"
+	StopwordsLatvian    Stopwords = "_latvian_"
+	StopwordsLithuanian Stopwords = "_lithuanian_"
+	StopwordsNorwegian  Stopwords = "_norwegian_"
+	StopwordsPersian    Stopwords = "_persian_"
+	StopwordsPortuguese Stopwords = "_portuguese_"
+	StopwordsRomanian   Stopwords = "_romanian_"
+	StopwordsRussian    Stopwords = "_russian_"
+	StopwordsSorani     Stopwords = "_sorani_"
+	StopwordsSpanish    Stopwords = "_spanish_"
+	StopwordsSwedish    Stopwords = "_swedish_"
+	StopwordsThai       Stopwords = "_thai_"
+	StopwordsTurkish    Stopwords = "_turkish_"
+	// StopwordsNone disables stop words removal.
+	StopwordsNone Stopwords = "_none_"
+)
+
+// String returns the name of the pre-defined stop words list.
+func (s Stopwords) String() string {
+	return string(s)
+}
+
+// Valid returns whether the pre-defined stop words list is known to Elasticsearch.
+func (s Stopwords) Valid() bool {
+	_, valid := predefinedStopwords[s]
+	return valid
+}
+
+// Words returns a copy of the words contained in the pre-defined stop words list.
+func (s Stopwords) Words() ([]string, error) {
+	words, valid := predefinedStopwords[s]
+	if !valid {
+		return nil, fmt.Errorf("unknown pre-defined stop words list: %s", s)
+	}
+	return append([]string(nil), words...), nil
+}
+
+// PredefinedStopwords returns the names of all pre-defined stop words lists, sorted by name.
+func PredefinedStopwords() []Stopwords {
+	names := make([]Stopwords, 0, len(predefinedStopwords))
+	for name := range predefinedStopwords {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+// isStopwordsName returns whether value is written like a pre-defined stop words list
+// name, i.e. "_xxx_".
+func isStopwordsName(value string) bool {
+	return len(value) > 2 && strings.HasPrefix(value, "_") && strings.HasSuffix(value, "_")
+}
+
+// ValidateStopwords validates a `stopwords` parameter value. Every value written like a
+// pre-defined list name ("_xxx_") must be a list known to Elasticsearch, otherwise it would
+// silently be treated as a literal stop word.
+func ValidateStopwords(stopwords ...string) error {
+	var unknown []string
+	for _, value := range stopwords {
+		if isStopwordsName(value) && !Stopwords(value).Valid() {
+			unknown = append(unknown, value)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown pre-defined stop words lists: %v", unknown)
+	}
+	return nil
+}
+
+// StopwordsList stop words list builder which extends or subtracts words from one or more
+// pre-defined stop words lists. The resulting list can either be set inline through the
+// `stopwords` parameter or written into a file referenced by `stopwords_path`.
+type StopwordsList struct {
+	base   []Stopwords
+	add    []string
+	remove []string
+}
+
+// NewStopwordsList initializes a new StopwordsList based on the pre-defined stop words lists.
+func NewStopwordsList(base ...Stopwords) *StopwordsList {
+	return &StopwordsList{
+		base:   base,
+		add:    make([]string, 0),
+		remove: make([]string, 0),
+	}
+}
+
+// Base sets additional pre-defined stop words lists to build upon.
+func (l *StopwordsList) Base(base ...Stopwords) *StopwordsList {
+	l.base = append(l.base, base...)
+	return l
+}
+
+// Add sets the words to be added to the stop words list.
+func (l *StopwordsList) Add(words ...string) *StopwordsList {
+	l.add = append(l.add, words...)
+	return l
+}
+
+// Remove sets the words to be removed from the stop words list.
+func (l *StopwordsList) Remove(words ...string) *StopwordsList {
+	l.remove = append(l.remove, words...)
+	return l
+}
+
+// Validate validates StopwordsList.
+func (l *StopwordsList) Validate() error {
+	var invalid []string
+	for _, b := range l.base {
+		if !b.Valid() {
+			invalid = append(invalid, "Base")
+			break
+		}
+	}
+	for _, w := range l.add {
+		if strings.TrimSpace(w) == "" || strings.ContainsAny(w, "\r\n") {
+			invalid = append(invalid, "Add")
+			break
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Words returns the resulting stop words, without duplicates, in the order they were first
+// seen.
+func (l *StopwordsList) Words() ([]string, error) {
+	if err := l.Validate(); err != nil {
+		return nil, err
+	}
+	removed := make(map[string]bool)
+	for _, w := range l.remove {
+		removed[w] = true
+	}
+	seen := make(map[string]bool)
+	words := make([]string, 0)
+	appendWord := func(w string) {
+		if removed[w] || seen[w] {
+			return
+		}
+		seen[w] = true
+		words = append(words, w)
+	}
+	for _, b := range l.base {
+		for _, w := range predefinedStopwords[b] {
+			appendWord(w)
+		}
+	}
+	for _, w := range l.add {
+		appendWord(w)
+	}
+	return words, nil
+}
+
+// File returns the content of a `stopwords_path` file for the resulting stop words. The file
+// is UTF-8 encoded with one stop word per line.
+func (l *StopwordsList) File() ([]byte, error) {
+	words, err := l.Words()
+	if err != nil {
+		return nil, err
+	}
+	if len(words) == 0 {
+		return []byte{}, nil
+	}
+	return []byte(strings.Join(words, "\n") + "\n"), nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (l *StopwordsList) Source() (interface{}, error) {
+	// ["a", "an", "and"]
+	return l.Words()
+}
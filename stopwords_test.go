@@ -0,0 +1,103 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStopwordsListSerialization(t *testing.T) {
+	tests := []struct {
+		desc     string
+		l        *StopwordsList
+		expected string
+	}{
+		// #0
+		{
+			desc:     "Pre-defined list.",
+			l:        NewStopwordsList(StopwordsEnglish),
+			expected: `["a","an","and","are","as","at","be","but","by","for","if","in","into","is","it","no","not","of","on","or","such","that","the","their","then","there","these","they","this","to","was","will","with"]`,
+		},
+		// #1
+		{
+			desc:     "Pre-defined list with Add and Remove.",
+			l:        NewStopwordsList(StopwordsEnglish).Remove("a", "an", "and", "are", "as", "at", "be", "but", "by", "for", "if", "in", "into", "is", "it", "no", "not", "of", "on", "or", "such", "that", "the", "their", "then", "there", "these", "they", "this", "to", "was").Add("will", "foo", "bar"),
+			expected: `["will","with","foo","bar"]`,
+		},
+		// #2
+		{
+			desc:     "None with Add.",
+			l:        NewStopwordsList(StopwordsNone).Add("foo", "foo"),
+			expected: `["foo"]`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.l.Source()
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestStopwordsListFile(t *testing.T) {
+	data, err := NewStopwordsList(StopwordsNone).Add("foo", "bar").File()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := string(data), "foo\nbar\n"; got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestValidateStopwords(t *testing.T) {
+	tests := []struct {
+		desc      string
+		stopwords []string
+		valid     bool
+	}{
+		// #0
+		{
+			desc:      "Pre-defined lists.",
+			stopwords: []string{"_english_", "_french_", "_none_"},
+			valid:     true,
+		},
+		// #1
+		{
+			desc:      "Literal words.",
+			stopwords: []string{"and", "is", "_"},
+			valid:     true,
+		},
+		// #2
+		{
+			desc:      "Unknown pre-defined list.",
+			stopwords: []string{"_english_", "_klingon_"},
+			valid:     false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := ValidateStopwords(test.stopwords...)
+			if test.valid && err != nil {
+				t.Errorf("expected valid, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+	if err := NewTokenFilterStop("test").Stopwords("_englsh_").Validate(true); err == nil {
+		t.Error("expected TokenFilterStop validation error, got nil")
+	}
+}
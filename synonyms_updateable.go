@@ -0,0 +1,125 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// updateableSynonymAnalyzers returns the names of the analyzers using an updateable synonym
+// filter, directly or wrapped in a `multiplexer` or `condition` token filter, mapped to the
+// name of the updateable filter. The default analyzer is named "default".
+func (a *Analysis) updateableSynonymAnalyzers() map[string]string {
+	updateable := make(map[string]bool)
+	for _, f := range a.filter {
+		switch t := f.(type) {
+		case *TokenFilterSynonym:
+			updateable[t.name] = t.updateable != nil && *t.updateable
+		case *TokenFilterSynonymGraph:
+			updateable[t.name] = t.updateable != nil && *t.updateable
+		}
+	}
+	analyzers := make(map[string]string)
+	check := func(name string, _a Analyzer) {
+		c, ok := _a.(*AnalyzerCustom)
+		if !ok {
+			return
+		}
+		for _, f := range c.filter {
+			for _, n := range append([]string{f}, a.wrappedFilters(f)...) {
+				if updateable[n] {
+					analyzers[name] = n
+					return
+				}
+			}
+		}
+	}
+	if a.defaultAnalyzer != nil {
+		check("default", a.defaultAnalyzer)
+	}
+	for _, _a := range a.analyzer {
+		if _a != a.defaultAnalyzer {
+			check(_a.Name(), _a)
+		}
+	}
+	return analyzers
+}
+
+// ValidateUpdateableSynonyms validates that the analyzers using updateable synonym filters are
+// only used at search time, ie through `search_analyzer` or `search_quote_analyzer`, as
+// Elasticsearch rejects updateable filters in index-time analyzers, including the default
+// analyzer and the `analyzer` of fields and dynamic templates.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/indices-reload-analyzers.html
+// for details.
+func (i *Index) ValidateUpdateableSynonyms() error {
+	if i.analysis == nil {
+		return nil
+	}
+	analyzers := i.analysis.updateableSynonymAnalyzers()
+	if len(analyzers) == 0 {
+		return nil
+	}
+	var invalid []string
+	if filter, exists := analyzers["default"]; exists {
+		invalid = append(invalid, fmt.Sprintf("analyzer [default] uses updateable filter [%s] and is "+
+			"used at index time as the default analyzer", filter))
+	}
+	check := func(field, analyzer string) {
+		if filter, exists := analyzers[analyzer]; exists {
+			invalid = append(invalid, fmt.Sprintf("analyzer [%s] uses updateable filter [%s] and is used "+
+				"at index time by field [%s], only use it as search_analyzer", analyzer, filter, field))
+		}
+	}
+	indexAnalyzer := func(path string, d Datatype) {
+		switch t := d.(type) {
+		case *DatatypeText:
+			check(path, t.analyzer)
+		case *DatatypeSearchAsYouType:
+			check(path, t.analyzer)
+		case *DatatypeCompletion:
+			check(path, t.analyzer)
+		case *DatatypeTokenCount:
+			check(path, t.analyzer)
+		}
+	}
+	if m := i.mappings; m != nil {
+		walkDatatypes("", m.properties, indexAnalyzer)
+		for _, t := range m.dynamicTemplates {
+			if t.mapping != nil {
+				walkDatatypes("", []Datatype{t.mapping}, func(path string, d Datatype) {
+					indexAnalyzer("dynamic_template ["+t.name+"]", d)
+				})
+			}
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid updateable synonyms: %v", invalid)
+	}
+	return nil
+}
+
+// ReloadSearchAnalyzersPath returns the path of the `_reload_search_analyzers` request for the
+// indices, keyed by index name, which use updateable synonym filters, eg
+// "/index-1,index-2/_reload_search_analyzers". The request is sent as a POST request without
+// body. Returns an empty string when none of the indices uses updateable synonym filters.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/indices-reload-analyzers.html
+// for details.
+func ReloadSearchAnalyzersPath(indices map[string]*Index) string {
+	var names []string
+	for name, i := range indices {
+		if i != nil && i.analysis != nil && len(i.analysis.updateableSynonymAnalyzers()) > 0 {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	sort.Strings(names)
+	return "/" + strings.Join(names, ",") + "/_reload_search_analyzers"
+}
@@ -0,0 +1,106 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIndexValidateUpdateableSynonyms(t *testing.T) {
+	analysis := func() *Analysis {
+		return NewAnalysis().
+			Analyzer(
+				NewAnalyzerCustom("search_synonyms", "standard").Filter("lowercase", "synonyms"),
+				NewAnalyzerCustom("multiplexed_synonyms", "standard").Filter("multiplexer"),
+			).
+			Filter(
+				NewTokenFilterSynonymGraph("synonyms").Updateable(true).SynonymsPath("analysis/synonym.txt"),
+				NewTokenFilterMultiplexer("multiplexer").Filters("lowercase", "lowercase, synonyms"),
+			)
+	}
+	tests := []struct {
+		desc     string
+		i        *Index
+		expected []string
+	}{
+		// #0
+		{
+			desc: "Search analyzers only.",
+			i: NewIndex().Analysis(analysis()).Mappings(NewMappings().Properties(
+				NewDatatypeText("title").Analyzer("standard").SearchAnalyzer("search_synonyms").SearchQuoteAnalyzer("multiplexed_synonyms"),
+			)),
+		},
+		// #1
+		{
+			desc: "Index analyzers.",
+			i: NewIndex().Analysis(analysis()).Mappings(NewMappings().
+				Properties(
+					NewDatatypeObject("product").Properties(
+						NewDatatypeText("title").Analyzer("search_synonyms"),
+					),
+					NewDatatypeCompletion("suggest").Analyzer("multiplexed_synonyms"),
+				).
+				DynamicTemplates(NewDynamicTemplate("strings").MatchMappingType("string").Mapping(NewDatatypeText("{name}").Analyzer("search_synonyms"))),
+			),
+			expected: []string{
+				"analyzer [search_synonyms] uses updateable filter [synonyms] and is used at index time by field [product.title]",
+				"analyzer [multiplexed_synonyms] uses updateable filter [synonyms] and is used at index time by field [suggest]",
+				"analyzer [search_synonyms] uses updateable filter [synonyms] and is used at index time by field [dynamic_template [strings]]",
+			},
+		},
+		// #2
+		{
+			desc: "Default analyzer.",
+			i: NewIndex().Analysis(NewAnalysis().
+				DefaultAnalyzer(NewAnalyzerCustom("default", "standard").Filter("synonyms")).
+				Filter(NewTokenFilterSynonym("synonyms").Updateable(true)),
+			),
+			expected: []string{"analyzer [default] uses updateable filter [synonyms] and is used at index time as the default analyzer"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.i.ValidateUpdateableSynonyms()
+			if len(test.expected) == 0 {
+				if err != nil {
+					t.Errorf("expected valid, got: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			for _, expected := range test.expected {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error to contain %q, got: %v", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestReloadSearchAnalyzersPath(t *testing.T) {
+	updateable := NewIndex().Analysis(NewAnalysis().
+		Analyzer(NewAnalyzerCustom("search_synonyms", "standard").Filter("synonyms")).
+		Filter(NewTokenFilterSynonym("synonyms").Updateable(true)),
+	)
+	static := NewIndex().Analysis(NewAnalysis().
+		Analyzer(NewAnalyzerCustom("search_synonyms", "standard").Filter("synonyms")).
+		Filter(NewTokenFilterSynonym("synonyms")),
+	)
+	got := ReloadSearchAnalyzersPath(map[string]*Index{
+		"products-2": updateable,
+		"products-1": updateable,
+		"logs":       static,
+		"empty":      NewIndex(),
+	})
+	if expected := "/products-1,products-2/_reload_search_analyzers"; got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+	if got := ReloadSearchAnalyzersPath(map[string]*Index{"logs": static}); got != "" {
+		t.Errorf("expected empty path, got: %s", got)
+	}
+}
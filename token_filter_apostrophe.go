@@ -0,0 +1,62 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterApostrophe token filter that strips all characters after an apostrophe, including the apostrophe
+// itself. Commonly used for Turkish, eg "Istanbul'a" => "Istanbul".
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-apostrophe-tokenfilter.html
+// for details.
+type TokenFilterApostrophe struct {
+	TokenFilter
+	name string
+
+	// fields specific to apostrophe token filter
+}
+
+// NewTokenFilterApostrophe initializes a new TokenFilterApostrophe.
+func NewTokenFilterApostrophe(name string) *TokenFilterApostrophe {
+	return &TokenFilterApostrophe{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (a *TokenFilterApostrophe) Name() string {
+	return a.name
+}
+
+// Validate validates TokenFilterApostrophe.
+func (a *TokenFilterApostrophe) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && a.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (a *TokenFilterApostrophe) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "apostrophe"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "apostrophe"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[a.name] = options
+	return source, nil
+}
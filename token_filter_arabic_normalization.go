@@ -0,0 +1,61 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterArabicNormalization token filter that normalizes Arabic text.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-normalization-tokenfilter.html
+// for details.
+type TokenFilterArabicNormalization struct {
+	TokenFilter
+	name string
+
+	// fields specific to arabic normalization token filter
+}
+
+// NewTokenFilterArabicNormalization initializes a new TokenFilterArabicNormalization.
+func NewTokenFilterArabicNormalization(name string) *TokenFilterArabicNormalization {
+	return &TokenFilterArabicNormalization{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (n *TokenFilterArabicNormalization) Name() string {
+	return n.name
+}
+
+// Validate validates TokenFilterArabicNormalization.
+func (n *TokenFilterArabicNormalization) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && n.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (n *TokenFilterArabicNormalization) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "arabic_normalization"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "arabic_normalization"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[n.name] = options
+	return source, nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterCJKWidth token filter that normalizes width differences in CJK (Chinese, Japanese, and Korean)
+// characters. Full-width ASCII variants are folded into basic Latin and half-width Katakana variants
+// into their full-width equivalents.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-cjk-width-tokenfilter.html
+// for details.
+type TokenFilterCJKWidth struct {
+	TokenFilter
+	name string
+
+	// fields specific to cjk width token filter
+}
+
+// NewTokenFilterCJKWidth initializes a new TokenFilterCJKWidth.
+func NewTokenFilterCJKWidth(name string) *TokenFilterCJKWidth {
+	return &TokenFilterCJKWidth{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (w *TokenFilterCJKWidth) Name() string {
+	return w.name
+}
+
+// Validate validates TokenFilterCJKWidth.
+func (w *TokenFilterCJKWidth) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && w.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (w *TokenFilterCJKWidth) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "cjk_width"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "cjk_width"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[w.name] = options
+	return source, nil
+}
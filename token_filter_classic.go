@@ -0,0 +1,62 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterClassic token filter that performs optional post-processing of terms generated by the `classic`
+// tokenizer. It removes the english possessive from the end of words, and removes dots from acronyms.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-classic-tokenfilter.html
+// for details.
+type TokenFilterClassic struct {
+	TokenFilter
+	name string
+
+	// fields specific to classic token filter
+}
+
+// NewTokenFilterClassic initializes a new TokenFilterClassic.
+func NewTokenFilterClassic(name string) *TokenFilterClassic {
+	return &TokenFilterClassic{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (c *TokenFilterClassic) Name() string {
+	return c.name
+}
+
+// Validate validates TokenFilterClassic.
+func (c *TokenFilterClassic) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && c.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (c *TokenFilterClassic) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "classic"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "classic"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[c.name] = options
+	return source, nil
+}
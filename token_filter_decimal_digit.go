@@ -0,0 +1,62 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterDecimalDigit token filter that converts all digits in the Unicode decimal number general category
+// to basic Latin digits (0-9).
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-decimal-digit-tokenfilter.html
+// for details.
+type TokenFilterDecimalDigit struct {
+	TokenFilter
+	name string
+
+	// fields specific to decimal digit token filter
+}
+
+// NewTokenFilterDecimalDigit initializes a new TokenFilterDecimalDigit.
+func NewTokenFilterDecimalDigit(name string) *TokenFilterDecimalDigit {
+	return &TokenFilterDecimalDigit{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (d *TokenFilterDecimalDigit) Name() string {
+	return d.name
+}
+
+// Validate validates TokenFilterDecimalDigit.
+func (d *TokenFilterDecimalDigit) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && d.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (d *TokenFilterDecimalDigit) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "decimal_digit"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "decimal_digit"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[d.name] = options
+	return source, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterFlattenGraph token filter that flattens a token graph produced by a graph token filter, such as
+// `synonym_graph` or `word_delimiter_graph`, so that it can be indexed.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-flatten-graph-tokenfilter.html
+// for details.
+type TokenFilterFlattenGraph struct {
+	TokenFilter
+	name string
+
+	// fields specific to flatten graph token filter
+}
+
+// NewTokenFilterFlattenGraph initializes a new TokenFilterFlattenGraph.
+func NewTokenFilterFlattenGraph(name string) *TokenFilterFlattenGraph {
+	return &TokenFilterFlattenGraph{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (f *TokenFilterFlattenGraph) Name() string {
+	return f.name
+}
+
+// Validate validates TokenFilterFlattenGraph.
+func (f *TokenFilterFlattenGraph) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && f.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (f *TokenFilterFlattenGraph) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "flatten_graph"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "flatten_graph"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[f.name] = options
+	return source, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterGermanNormalization token filter that normalizes German characters, eg "ä" => "a" and "ß" => "ss".
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-normalization-tokenfilter.html
+// for details.
+type TokenFilterGermanNormalization struct {
+	TokenFilter
+	name string
+
+	// fields specific to german normalization token filter
+}
+
+// NewTokenFilterGermanNormalization initializes a new TokenFilterGermanNormalization.
+func NewTokenFilterGermanNormalization(name string) *TokenFilterGermanNormalization {
+	return &TokenFilterGermanNormalization{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (n *TokenFilterGermanNormalization) Name() string {
+	return n.name
+}
+
+// Validate validates TokenFilterGermanNormalization.
+func (n *TokenFilterGermanNormalization) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && n.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (n *TokenFilterGermanNormalization) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "german_normalization"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "german_normalization"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[n.name] = options
+	return source, nil
+}
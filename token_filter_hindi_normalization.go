@@ -0,0 +1,61 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterHindiNormalization token filter that normalizes Hindi text.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-normalization-tokenfilter.html
+// for details.
+type TokenFilterHindiNormalization struct {
+	TokenFilter
+	name string
+
+	// fields specific to hindi normalization token filter
+}
+
+// NewTokenFilterHindiNormalization initializes a new TokenFilterHindiNormalization.
+func NewTokenFilterHindiNormalization(name string) *TokenFilterHindiNormalization {
+	return &TokenFilterHindiNormalization{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (n *TokenFilterHindiNormalization) Name() string {
+	return n.name
+}
+
+// Validate validates TokenFilterHindiNormalization.
+func (n *TokenFilterHindiNormalization) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && n.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (n *TokenFilterHindiNormalization) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "hindi_normalization"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "hindi_normalization"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[n.name] = options
+	return source, nil
+}
@@ -0,0 +1,256 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterICUCollation (Plugin) token filter that collates terms, so that they can be used for
+// language-sensitive sorting. Collation keys are indexed as binary terms, so the filter is
+// generally used with the `keyword` tokenizer.
+// ! This token filter is deprecated, use the `icu_collation_keyword` datatype instead.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-icu-collation.html
+// for details.
+type TokenFilterICUCollation struct {
+	TokenFilter
+	name string
+
+	// fields specific to icu collation token filter
+	language               string
+	country                string
+	variant                string
+	rules                  string
+	strength               string
+	decomposition          string
+	alternate              string
+	caseLevel              *bool
+	caseFirst              string
+	numeric                *bool
+	variableTop            string
+	hiraganaQuaternaryMode *bool
+}
+
+// NewTokenFilterICUCollation initializes a new TokenFilterICUCollation.
+func NewTokenFilterICUCollation(name string) *TokenFilterICUCollation {
+	return &TokenFilterICUCollation{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (c *TokenFilterICUCollation) Name() string {
+	return c.name
+}
+
+// Language sets the language of the locale used for collation, eg "de". Ignored when `rules`
+// is set.
+func (c *TokenFilterICUCollation) Language(language string) *TokenFilterICUCollation {
+	c.language = language
+	return c
+}
+
+// Country sets the country of the locale used for collation, eg "DE". Ignored when `rules`
+// is set.
+func (c *TokenFilterICUCollation) Country(country string) *TokenFilterICUCollation {
+	c.country = country
+	return c
+}
+
+// Variant sets the variant of the locale used for collation, eg "@collation=phonebook".
+// Ignored when `rules` is set.
+func (c *TokenFilterICUCollation) Variant(variant string) *TokenFilterICUCollation {
+	c.variant = variant
+	return c
+}
+
+// Rules sets custom tailored collation rules.
+//
+// See http://userguide.icu-project.org/collation/customization
+// for details.
+func (c *TokenFilterICUCollation) Rules(rules string) *TokenFilterICUCollation {
+	c.rules = rules
+	return c
+}
+
+// Strength sets the comparison level.
+// Can be set to the following values:
+// "primary" - Base characters only.
+// "secondary" - Base characters and accents.
+// "tertiary" - Base characters, accents and case.
+// "quaternary" - Same as "tertiary" with punctuation, used with Alternate "shifted".
+// "identical" - All differences are significant.
+// Defaults to "tertiary" for most locales.
+func (c *TokenFilterICUCollation) Strength(strength string) *TokenFilterICUCollation {
+	c.strength = strength
+	return c
+}
+
+// Decomposition sets whether canonical decomposition is performed on the text.
+// Can be set to the following values:
+// "no" - No decomposition.
+// "canonical" - Canonical decomposition.
+// Defaults to "no".
+func (c *TokenFilterICUCollation) Decomposition(decomposition string) *TokenFilterICUCollation {
+	c.decomposition = decomposition
+	return c
+}
+
+// Alternate sets how variable characters such as whitespace and punctuation are handled.
+// Can be set to the following values:
+// "shifted" - Ignore variable characters, unless Strength is "quaternary".
+// "non-ignorable" - Variable characters are significant.
+// Defaults to "non-ignorable".
+func (c *TokenFilterICUCollation) Alternate(alternate string) *TokenFilterICUCollation {
+	c.alternate = alternate
+	return c
+}
+
+// CaseLevel sets whether case should be distinguished, even when Strength is "primary".
+// Defaults to false.
+func (c *TokenFilterICUCollation) CaseLevel(caseLevel bool) *TokenFilterICUCollation {
+	c.caseLevel = &caseLevel
+	return c
+}
+
+// CaseFirst sets which case sorts first when case is distinguished.
+// Can be set to the following values:
+// "lower" - Lowercase sorts before uppercase.
+// "upper" - Uppercase sorts before lowercase.
+func (c *TokenFilterICUCollation) CaseFirst(caseFirst string) *TokenFilterICUCollation {
+	c.caseFirst = caseFirst
+	return c
+}
+
+// Numeric sets whether digits are sorted according to their numeric representation, eg
+// "egg-9" sorts before "egg-21".
+// Defaults to false.
+func (c *TokenFilterICUCollation) Numeric(numeric bool) *TokenFilterICUCollation {
+	c.numeric = &numeric
+	return c
+}
+
+// VariableTop sets the single character or contraction up to which characters are treated as
+// variable. Only effective when Alternate is "shifted".
+func (c *TokenFilterICUCollation) VariableTop(variableTop string) *TokenFilterICUCollation {
+	c.variableTop = variableTop
+	return c
+}
+
+// HiraganaQuaternaryMode sets whether Katakana and Hiragana characters are distinguished at
+// the quaternary level.
+// Defaults to false.
+func (c *TokenFilterICUCollation) HiraganaQuaternaryMode(hiraganaQuaternaryMode bool) *TokenFilterICUCollation {
+	c.hiraganaQuaternaryMode = &hiraganaQuaternaryMode
+	return c
+}
+
+// Validate validates TokenFilterICUCollation.
+func (c *TokenFilterICUCollation) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && c.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if c.strength != "" {
+		if _, valid := map[string]bool{
+			"primary":    true,
+			"secondary":  true,
+			"tertiary":   true,
+			"quaternary": true,
+			"identical":  true,
+		}[c.strength]; !valid {
+			invalid = append(invalid, "Strength")
+		}
+	}
+	if c.decomposition != "" {
+		if _, valid := map[string]bool{
+			"no":        true,
+			"canonical": true,
+		}[c.decomposition]; !valid {
+			invalid = append(invalid, "Decomposition")
+		}
+	}
+	if c.alternate != "" {
+		if _, valid := map[string]bool{
+			"shifted":       true,
+			"non-ignorable": true,
+		}[c.alternate]; !valid {
+			invalid = append(invalid, "Alternate")
+		}
+	}
+	if c.caseFirst != "" {
+		if _, valid := map[string]bool{
+			"lower": true,
+			"upper": true,
+		}[c.caseFirst]; !valid {
+			invalid = append(invalid, "CaseFirst")
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (c *TokenFilterICUCollation) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "icu_collation",
+	// 		"language": "de",
+	// 		"country": "DE",
+	// 		"variant": "@collation=phonebook",
+	// 		"strength": "primary",
+	// 		"alternate": "shifted",
+	// 		"numeric": true
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "icu_collation"
+
+	if c.language != "" {
+		options["language"] = c.language
+	}
+	if c.country != "" {
+		options["country"] = c.country
+	}
+	if c.variant != "" {
+		options["variant"] = c.variant
+	}
+	if c.rules != "" {
+		options["rules"] = c.rules
+	}
+	if c.strength != "" {
+		options["strength"] = c.strength
+	}
+	if c.decomposition != "" {
+		options["decomposition"] = c.decomposition
+	}
+	if c.alternate != "" {
+		options["alternate"] = c.alternate
+	}
+	if c.caseLevel != nil {
+		options["case_level"] = c.caseLevel
+	}
+	if c.caseFirst != "" {
+		options["case_first"] = c.caseFirst
+	}
+	if c.numeric != nil {
+		options["numeric"] = c.numeric
+	}
+	if c.variableTop != "" {
+		options["variable_top"] = c.variableTop
+	}
+	if c.hiraganaQuaternaryMode != nil {
+		options["hiragana_quaternary_mode"] = c.hiraganaQuaternaryMode
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[c.name] = options
+	return source, nil
+}
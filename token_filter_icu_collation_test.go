@@ -0,0 +1,49 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTokenFilterICUCollationSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		c           *TokenFilterICUCollation
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name with Language and Country and Variant.",
+			c:           NewTokenFilterICUCollation("test").Language("de").Country("DE").Variant("@collation=phonebook"),
+			includeName: true,
+			expected:    `{"test":{"country":"DE","language":"de","type":"icu_collation","variant":"@collation=phonebook"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with Strength and Alternate and Numeric and CaseLevel.",
+			c:           NewTokenFilterICUCollation("test").Strength("primary").Alternate("shifted").Numeric(true).CaseLevel(true),
+			includeName: false,
+			expected:    `{"alternate":"shifted","case_level":true,"numeric":true,"strength":"primary","type":"icu_collation"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.c.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
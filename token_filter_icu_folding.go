@@ -0,0 +1,79 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterICUFolding (Plugin) token filter that does case folding and removes accents and other
+// diacritics. It is a superset of the `asciifolding` token filter and applies the `nfkc_cf`
+// normalization, so there is no need to use it together with `lowercase` or `icu_normalizer`.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-icu-folding.html
+// for details.
+type TokenFilterICUFolding struct {
+	TokenFilter
+	name string
+
+	// fields specific to icu folding token filter
+	unicodeSetFilter string
+}
+
+// NewTokenFilterICUFolding initializes a new TokenFilterICUFolding.
+func NewTokenFilterICUFolding(name string) *TokenFilterICUFolding {
+	return &TokenFilterICUFolding{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (f *TokenFilterICUFolding) Name() string {
+	return f.name
+}
+
+// UnicodeSetFilter sets the UnicodeSet which specifies which characters are folded, eg
+// "[^åäöÅÄÖ]" to exclude Swedish characters from folding.
+//
+// See https://unicode-org.github.io/icu-docs/apidoc/released/icu4j/com/ibm/icu/text/UnicodeSet.html
+// for details.
+func (f *TokenFilterICUFolding) UnicodeSetFilter(unicodeSetFilter string) *TokenFilterICUFolding {
+	f.unicodeSetFilter = unicodeSetFilter
+	return f
+}
+
+// Validate validates TokenFilterICUFolding.
+func (f *TokenFilterICUFolding) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && f.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (f *TokenFilterICUFolding) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "icu_folding",
+	// 		"unicode_set_filter": "[^åäöÅÄÖ]"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "icu_folding"
+
+	if f.unicodeSetFilter != "" {
+		options["unicode_set_filter"] = f.unicodeSetFilter
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[f.name] = options
+	return source, nil
+}
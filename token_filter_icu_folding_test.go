@@ -0,0 +1,49 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTokenFilterICUFoldingSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		f           *TokenFilterICUFolding
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name.",
+			f:           NewTokenFilterICUFolding("test"),
+			includeName: true,
+			expected:    `{"test":{"type":"icu_folding"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with UnicodeSetFilter.",
+			f:           NewTokenFilterICUFolding("test").UnicodeSetFilter("[^åäöÅÄÖ]"),
+			includeName: false,
+			expected:    `{"type":"icu_folding","unicode_set_filter":"[^åäöÅÄÖ]"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.f.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterICUNormalizer (Plugin) token filter that normalizes characters as explained in
+// Unicode Normalization Forms.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-icu-normalization.html
+// for details.
+type TokenFilterICUNormalizer struct {
+	TokenFilter
+	name string
+
+	// fields specific to icu normalizer token filter
+	normalization    string
+	unicodeSetFilter string
+}
+
+// NewTokenFilterICUNormalizer initializes a new TokenFilterICUNormalizer.
+func NewTokenFilterICUNormalizer(name string) *TokenFilterICUNormalizer {
+	return &TokenFilterICUNormalizer{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (n *TokenFilterICUNormalizer) Name() string {
+	return n.name
+}
+
+// NormalizationName sets the type of normalization.
+// Can be set to the following values:
+// "nfc" - Canonical Decomposition, followed by Canonical Composition.
+// "nfkc" - Compatibility Decomposition, followed by Canonical Composition.
+// "nfkc_cf" - Same as "nfkc" with case folding.
+// Defaults to "nfkc_cf".
+func (n *TokenFilterICUNormalizer) NormalizationName(normalization string) *TokenFilterICUNormalizer {
+	n.normalization = normalization
+	return n
+}
+
+// UnicodeSetFilter sets the UnicodeSet which specifies which characters are normalized, eg
+// "[^ß]" to exclude "ß" from normalization.
+//
+// See https://unicode-org.github.io/icu-docs/apidoc/released/icu4j/com/ibm/icu/text/UnicodeSet.html
+// for details.
+func (n *TokenFilterICUNormalizer) UnicodeSetFilter(unicodeSetFilter string) *TokenFilterICUNormalizer {
+	n.unicodeSetFilter = unicodeSetFilter
+	return n
+}
+
+// Validate validates TokenFilterICUNormalizer.
+func (n *TokenFilterICUNormalizer) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && n.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if n.normalization != "" {
+		if _, valid := map[string]bool{
+			"nfc":     true,
+			"nfkc":    true,
+			"nfkc_cf": true,
+		}[n.normalization]; !valid {
+			invalid = append(invalid, "NormalizationName")
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (n *TokenFilterICUNormalizer) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "icu_normalizer",
+	// 		"name": "nfc",
+	// 		"unicode_set_filter": "[^ß]"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "icu_normalizer"
+
+	if n.normalization != "" {
+		options["name"] = n.normalization
+	}
+	if n.unicodeSetFilter != "" {
+		options["unicode_set_filter"] = n.unicodeSetFilter
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[n.name] = options
+	return source, nil
+}
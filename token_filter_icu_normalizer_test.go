@@ -0,0 +1,49 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTokenFilterICUNormalizerSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		n           *TokenFilterICUNormalizer
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name with NormalizationName.",
+			n:           NewTokenFilterICUNormalizer("test").NormalizationName("nfc"),
+			includeName: true,
+			expected:    `{"test":{"name":"nfc","type":"icu_normalizer"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with UnicodeSetFilter.",
+			n:           NewTokenFilterICUNormalizer("test").UnicodeSetFilter("[^ß]"),
+			includeName: false,
+			expected:    `{"type":"icu_normalizer","unicode_set_filter":"[^ß]"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.n.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterICUTransform (Plugin) token filter that transforms text using ICU transforms, such
+// as transliteration from one script to another.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-icu-transform.html
+// for details.
+type TokenFilterICUTransform struct {
+	TokenFilter
+	name string
+
+	// fields specific to icu transform token filter
+	id  string
+	dir string
+}
+
+// NewTokenFilterICUTransform initializes a new TokenFilterICUTransform.
+func NewTokenFilterICUTransform(name string) *TokenFilterICUTransform {
+	return &TokenFilterICUTransform{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (t *TokenFilterICUTransform) Name() string {
+	return t.name
+}
+
+// ID sets the transform identifier, eg "Any-Latin; NFD; [:Nonspacing Mark:] Remove; NFC".
+//
+// See http://userguide.icu-project.org/transforms/general
+// for details.
+func (t *TokenFilterICUTransform) ID(id string) *TokenFilterICUTransform {
+	t.id = id
+	return t
+}
+
+// Dir sets the direction of the transform.
+// Can be set to the following values:
+// "forward" - Apply the transform as is.
+// "reverse" - Apply the inverse of the transform.
+// Defaults to "forward".
+func (t *TokenFilterICUTransform) Dir(dir string) *TokenFilterICUTransform {
+	t.dir = dir
+	return t
+}
+
+// Validate validates TokenFilterICUTransform.
+func (t *TokenFilterICUTransform) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && t.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if t.id == "" {
+		invalid = append(invalid, "ID")
+	}
+	if t.dir != "" {
+		if _, valid := map[string]bool{
+			"forward": true,
+			"reverse": true,
+		}[t.dir]; !valid {
+			invalid = append(invalid, "Dir")
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (t *TokenFilterICUTransform) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "icu_transform",
+	// 		"id": "Any-Latin; NFD; [:Nonspacing Mark:] Remove; NFC",
+	// 		"dir": "forward"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "icu_transform"
+
+	if t.id != "" {
+		options["id"] = t.id
+	}
+	if t.dir != "" {
+		options["dir"] = t.dir
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[t.name] = options
+	return source, nil
+}
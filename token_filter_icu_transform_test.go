@@ -0,0 +1,49 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTokenFilterICUTransformSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		t           *TokenFilterICUTransform
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name with ID.",
+			t:           NewTokenFilterICUTransform("test").ID("Any-Latin; NFD; [:Nonspacing Mark:] Remove; NFC"),
+			includeName: true,
+			expected:    `{"test":{"id":"Any-Latin; NFD; [:Nonspacing Mark:] Remove; NFC","type":"icu_transform"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with ID and Dir.",
+			t:           NewTokenFilterICUTransform("test").ID("Any-Latin").Dir("reverse"),
+			includeName: false,
+			expected:    `{"dir":"reverse","id":"Any-Latin","type":"icu_transform"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.t.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterIndicNormalization token filter that normalizes Unicode text in Indian languages.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-normalization-tokenfilter.html
+// for details.
+type TokenFilterIndicNormalization struct {
+	TokenFilter
+	name string
+
+	// fields specific to indic normalization token filter
+}
+
+// NewTokenFilterIndicNormalization initializes a new TokenFilterIndicNormalization.
+func NewTokenFilterIndicNormalization(name string) *TokenFilterIndicNormalization {
+	return &TokenFilterIndicNormalization{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (n *TokenFilterIndicNormalization) Name() string {
+	return n.name
+}
+
+// Validate validates TokenFilterIndicNormalization.
+func (n *TokenFilterIndicNormalization) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && n.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (n *TokenFilterIndicNormalization) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "indic_normalization"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "indic_normalization"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[n.name] = options
+	return source, nil
+}
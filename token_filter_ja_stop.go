@@ -0,0 +1,117 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterJaStop (Plugin) token filter that removes Japanese stop words. Only the "_japanese_"
+// pre-defined stop words list is recognised, any other value is treated as a literal stop word.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-kuromoji-stop.html
+// for details.
+type TokenFilterJaStop struct {
+	TokenFilter
+	name string
+
+	// fields specific to ja stop token filter
+	stopwords     []string
+	stopwordsPath string
+	ignoreCase    *bool
+}
+
+// NewTokenFilterJaStop initializes a new TokenFilterJaStop.
+func NewTokenFilterJaStop(name string) *TokenFilterJaStop {
+	return &TokenFilterJaStop{
+		name:      name,
+		stopwords: make([]string, 0),
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (s *TokenFilterJaStop) Name() string {
+	return s.name
+}
+
+// Stopwords sets the pre-defined "_japanese_" stop words list or an array containing a list
+// of stop words.
+// Defaults to "_japanese_".
+func (s *TokenFilterJaStop) Stopwords(stopwords ...string) *TokenFilterJaStop {
+	s.stopwords = append(s.stopwords, stopwords...)
+	return s
+}
+
+// StopwordsPath sets the path to a file containing stop words. This path must be absolute
+// or relative to the `config` location. The file must be UTF-8 encoded. Each stopword in the
+// file must be separated by a line break.
+func (s *TokenFilterJaStop) StopwordsPath(stopwordsPath string) *TokenFilterJaStop {
+	s.stopwordsPath = stopwordsPath
+	return s
+}
+
+// IgnoreCase sets whether to lowercase all words first or not.
+// Defaults to false.
+func (s *TokenFilterJaStop) IgnoreCase(ignoreCase bool) *TokenFilterJaStop {
+	s.ignoreCase = &ignoreCase
+	return s
+}
+
+// Validate validates TokenFilterJaStop.
+func (s *TokenFilterJaStop) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && s.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	for _, stopword := range s.stopwords {
+		if isStopwordsName(stopword) && stopword != "_japanese_" {
+			invalid = append(invalid, "Stopwords")
+			break
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (s *TokenFilterJaStop) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "ja_stop",
+	// 		"stopwords": ["_japanese_", "ストップ"]
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "ja_stop"
+
+	if len(s.stopwords) > 0 {
+		var stopwords interface{}
+		switch {
+		case len(s.stopwords) > 1:
+			stopwords = s.stopwords
+			break
+		case len(s.stopwords) == 1:
+			stopwords = s.stopwords[0]
+			break
+		default:
+			stopwords = ""
+		}
+		options["stopwords"] = stopwords
+	}
+	if s.stopwordsPath != "" {
+		options["stopwords_path"] = s.stopwordsPath
+	}
+	if s.ignoreCase != nil {
+		options["ignore_case"] = s.ignoreCase
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[s.name] = options
+	return source, nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTokenFilterJaStopSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		s           *TokenFilterJaStop
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name with Stopwords.",
+			s:           NewTokenFilterJaStop("test").Stopwords("_japanese_"),
+			includeName: true,
+			expected:    `{"test":{"stopwords":"_japanese_","type":"ja_stop"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with multiple Stopwords and IgnoreCase.",
+			s:           NewTokenFilterJaStop("test").Stopwords("_japanese_", "ストップ").IgnoreCase(true),
+			includeName: false,
+			expected:    `{"ignore_case":true,"stopwords":["_japanese_","ストップ"],"type":"ja_stop"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.s.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
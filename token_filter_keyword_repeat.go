@@ -0,0 +1,62 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterKeywordRepeat token filter that emits each incoming token twice, once as keyword and once as
+// non-keyword, so that a subsequent stemmer can index both the stemmed and unstemmed form.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-keyword-repeat-tokenfilter.html
+// for details.
+type TokenFilterKeywordRepeat struct {
+	TokenFilter
+	name string
+
+	// fields specific to keyword repeat token filter
+}
+
+// NewTokenFilterKeywordRepeat initializes a new TokenFilterKeywordRepeat.
+func NewTokenFilterKeywordRepeat(name string) *TokenFilterKeywordRepeat {
+	return &TokenFilterKeywordRepeat{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (k *TokenFilterKeywordRepeat) Name() string {
+	return k.name
+}
+
+// Validate validates TokenFilterKeywordRepeat.
+func (k *TokenFilterKeywordRepeat) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && k.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (k *TokenFilterKeywordRepeat) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "keyword_repeat"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "keyword_repeat"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[k.name] = options
+	return source, nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterKStem token filter that provides KStem-based stemming for the English language. It
+// combines algorithmic stemming with a built-in dictionary and is less aggressive than the
+// `porter_stem` token filter. Tokens marked as keywords, eg by the `keyword_marker` token filter,
+// are not stemmed.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-kstem-tokenfilter.html
+// for details.
+type TokenFilterKStem struct {
+	TokenFilter
+	name string
+
+	// fields specific to kstem token filter
+}
+
+// NewTokenFilterKStem initializes a new TokenFilterKStem.
+func NewTokenFilterKStem(name string) *TokenFilterKStem {
+	return &TokenFilterKStem{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (k *TokenFilterKStem) Name() string {
+	return k.name
+}
+
+// Validate validates TokenFilterKStem.
+func (k *TokenFilterKStem) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && k.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (k *TokenFilterKStem) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "kstem"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "kstem"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[k.name] = options
+	return source, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterKuromojiBaseform (Plugin) token filter that replaces the term with its base form
+// (dictionary form), eg "飲み" with "飲む".
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-kuromoji-baseform.html
+// for details.
+type TokenFilterKuromojiBaseform struct {
+	TokenFilter
+	name string
+
+	// fields specific to kuromoji baseform token filter
+}
+
+// NewTokenFilterKuromojiBaseform initializes a new TokenFilterKuromojiBaseform.
+func NewTokenFilterKuromojiBaseform(name string) *TokenFilterKuromojiBaseform {
+	return &TokenFilterKuromojiBaseform{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (b *TokenFilterKuromojiBaseform) Name() string {
+	return b.name
+}
+
+// Validate validates TokenFilterKuromojiBaseform.
+func (b *TokenFilterKuromojiBaseform) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && b.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (b *TokenFilterKuromojiBaseform) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "kuromoji_baseform"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "kuromoji_baseform"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[b.name] = options
+	return source, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterKuromojiNumber (Plugin) token filter that normalizes Japanese numbers (kansūji) to
+// regular Arabic decimal numbers in half-width characters, eg "一〇〇〇" to "1000".
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-kuromoji-number.html
+// for details.
+type TokenFilterKuromojiNumber struct {
+	TokenFilter
+	name string
+
+	// fields specific to kuromoji number token filter
+}
+
+// NewTokenFilterKuromojiNumber initializes a new TokenFilterKuromojiNumber.
+func NewTokenFilterKuromojiNumber(name string) *TokenFilterKuromojiNumber {
+	return &TokenFilterKuromojiNumber{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (n *TokenFilterKuromojiNumber) Name() string {
+	return n.name
+}
+
+// Validate validates TokenFilterKuromojiNumber.
+func (n *TokenFilterKuromojiNumber) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && n.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (n *TokenFilterKuromojiNumber) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "kuromoji_number"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "kuromoji_number"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[n.name] = options
+	return source, nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterKuromojiPartOfSpeech (Plugin) token filter that removes tokens that match a set of
+// part-of-speech tags.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-kuromoji-speech.html
+// for details.
+type TokenFilterKuromojiPartOfSpeech struct {
+	TokenFilter
+	name string
+
+	// fields specific to kuromoji part of speech token filter
+	stoptags []string
+}
+
+// NewTokenFilterKuromojiPartOfSpeech initializes a new TokenFilterKuromojiPartOfSpeech.
+func NewTokenFilterKuromojiPartOfSpeech(name string) *TokenFilterKuromojiPartOfSpeech {
+	return &TokenFilterKuromojiPartOfSpeech{
+		name:     name,
+		stoptags: make([]string, 0),
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (p *TokenFilterKuromojiPartOfSpeech) Name() string {
+	return p.name
+}
+
+// Stoptags sets the part-of-speech tags that should be removed, eg "助詞-格助詞-一般".
+// Defaults to the `stoptags.txt` file embedded in the `lucene-analyzer-kuromoji.jar`.
+func (p *TokenFilterKuromojiPartOfSpeech) Stoptags(stoptags ...string) *TokenFilterKuromojiPartOfSpeech {
+	p.stoptags = append(p.stoptags, stoptags...)
+	return p
+}
+
+// Validate validates TokenFilterKuromojiPartOfSpeech.
+func (p *TokenFilterKuromojiPartOfSpeech) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && p.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (p *TokenFilterKuromojiPartOfSpeech) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "kuromoji_part_of_speech",
+	// 		"stoptags": ["助詞-格助詞-一般", "助詞-終助詞"]
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "kuromoji_part_of_speech"
+
+	if len(p.stoptags) > 0 {
+		options["stoptags"] = p.stoptags
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[p.name] = options
+	return source, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterKuromojiReadingform (Plugin) token filter that replaces the token with its reading
+// form in either katakana or romaji.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-kuromoji-readingform.html
+// for details.
+type TokenFilterKuromojiReadingform struct {
+	TokenFilter
+	name string
+
+	// fields specific to kuromoji readingform token filter
+	useRomaji *bool
+}
+
+// NewTokenFilterKuromojiReadingform initializes a new TokenFilterKuromojiReadingform.
+func NewTokenFilterKuromojiReadingform(name string) *TokenFilterKuromojiReadingform {
+	return &TokenFilterKuromojiReadingform{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (r *TokenFilterKuromojiReadingform) Name() string {
+	return r.name
+}
+
+// UseRomaji sets whether romaji reading form should be output instead of katakana.
+// Defaults to false.
+func (r *TokenFilterKuromojiReadingform) UseRomaji(useRomaji bool) *TokenFilterKuromojiReadingform {
+	r.useRomaji = &useRomaji
+	return r
+}
+
+// Validate validates TokenFilterKuromojiReadingform.
+func (r *TokenFilterKuromojiReadingform) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && r.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (r *TokenFilterKuromojiReadingform) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "kuromoji_readingform",
+	// 		"use_romaji": true
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "kuromoji_readingform"
+
+	if r.useRomaji != nil {
+		options["use_romaji"] = r.useRomaji
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[r.name] = options
+	return source, nil
+}
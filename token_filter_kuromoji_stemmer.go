@@ -0,0 +1,78 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterKuromojiStemmer (Plugin) token filter that normalizes common katakana spelling
+// variations ending in a long sound character by removing this character (U+30FC).
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-kuromoji-stemmer.html
+// for details.
+type TokenFilterKuromojiStemmer struct {
+	TokenFilter
+	name string
+
+	// fields specific to kuromoji stemmer token filter
+	minimumLength *int
+}
+
+// NewTokenFilterKuromojiStemmer initializes a new TokenFilterKuromojiStemmer.
+func NewTokenFilterKuromojiStemmer(name string) *TokenFilterKuromojiStemmer {
+	return &TokenFilterKuromojiStemmer{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (s *TokenFilterKuromojiStemmer) Name() string {
+	return s.name
+}
+
+// MinimumLength sets the minimum length of katakana words that should be stemmed.
+// Defaults to 4.
+func (s *TokenFilterKuromojiStemmer) MinimumLength(minimumLength int) *TokenFilterKuromojiStemmer {
+	s.minimumLength = &minimumLength
+	return s
+}
+
+// Validate validates TokenFilterKuromojiStemmer.
+func (s *TokenFilterKuromojiStemmer) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && s.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if s.minimumLength != nil && *s.minimumLength < 2 {
+		invalid = append(invalid, "MinimumLength")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (s *TokenFilterKuromojiStemmer) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "kuromoji_stemmer",
+	// 		"minimum_length": 4
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "kuromoji_stemmer"
+
+	if s.minimumLength != nil {
+		options["minimum_length"] = s.minimumLength
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[s.name] = options
+	return source, nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTokenFilterKuromojiStemmerSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		s           *TokenFilterKuromojiStemmer
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name.",
+			s:           NewTokenFilterKuromojiStemmer("test"),
+			includeName: true,
+			expected:    `{"test":{"type":"kuromoji_stemmer"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with MinimumLength.",
+			s:           NewTokenFilterKuromojiStemmer("test").MinimumLength(6),
+			includeName: false,
+			expected:    `{"minimum_length":6,"type":"kuromoji_stemmer"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.s.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterNoriNumber (Plugin) token filter that normalizes Korean numbers to regular Arabic
+// decimal numbers in half-width characters, eg "영영칠" to "7".
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-nori-number.html
+// for details.
+type TokenFilterNoriNumber struct {
+	TokenFilter
+	name string
+
+	// fields specific to nori number token filter
+}
+
+// NewTokenFilterNoriNumber initializes a new TokenFilterNoriNumber.
+func NewTokenFilterNoriNumber(name string) *TokenFilterNoriNumber {
+	return &TokenFilterNoriNumber{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (n *TokenFilterNoriNumber) Name() string {
+	return n.name
+}
+
+// Validate validates TokenFilterNoriNumber.
+func (n *TokenFilterNoriNumber) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && n.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (n *TokenFilterNoriNumber) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "nori_number"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "nori_number"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[n.name] = options
+	return source, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterNoriPartOfSpeech (Plugin) token filter that removes tokens that match a set of
+// part-of-speech tags.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-nori-speech.html
+// for details.
+type TokenFilterNoriPartOfSpeech struct {
+	TokenFilter
+	name string
+
+	// fields specific to nori part of speech token filter
+	stoptags []string
+}
+
+// NewTokenFilterNoriPartOfSpeech initializes a new TokenFilterNoriPartOfSpeech.
+func NewTokenFilterNoriPartOfSpeech(name string) *TokenFilterNoriPartOfSpeech {
+	return &TokenFilterNoriPartOfSpeech{
+		name:     name,
+		stoptags: make([]string, 0),
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (p *TokenFilterNoriPartOfSpeech) Name() string {
+	return p.name
+}
+
+// Stoptags sets the part-of-speech tags that should be removed, eg "NR".
+// Defaults to the tags listed in the Lucene `KoreanPartOfSpeechStopFilter`, eg "E", "IC", "J".
+func (p *TokenFilterNoriPartOfSpeech) Stoptags(stoptags ...string) *TokenFilterNoriPartOfSpeech {
+	p.stoptags = append(p.stoptags, stoptags...)
+	return p
+}
+
+// Validate validates TokenFilterNoriPartOfSpeech.
+func (p *TokenFilterNoriPartOfSpeech) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && p.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	for _, stoptag := range p.stoptags {
+		if _, valid := map[string]bool{
+			"E":       true,
+			"IC":      true,
+			"J":       true,
+			"MAG":     true,
+			"MAJ":     true,
+			"MM":      true,
+			"NA":      true,
+			"NNB":     true,
+			"NNBC":    true,
+			"NNG":     true,
+			"NNP":     true,
+			"NP":      true,
+			"NR":      true,
+			"SC":      true,
+			"SE":      true,
+			"SF":      true,
+			"SH":      true,
+			"SL":      true,
+			"SN":      true,
+			"SP":      true,
+			"SSC":     true,
+			"SSO":     true,
+			"SY":      true,
+			"UNA":     true,
+			"UNKNOWN": true,
+			"VA":      true,
+			"VCN":     true,
+			"VCP":     true,
+			"VSV":     true,
+			"VV":      true,
+			"VX":      true,
+			"XPN":     true,
+			"XR":      true,
+			"XSA":     true,
+			"XSN":     true,
+			"XSV":     true,
+		}[stoptag]; !valid {
+			invalid = append(invalid, "Stoptags")
+			break
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (p *TokenFilterNoriPartOfSpeech) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "nori_part_of_speech",
+	// 		"stoptags": ["NR", "SP"]
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "nori_part_of_speech"
+
+	if len(p.stoptags) > 0 {
+		options["stoptags"] = p.stoptags
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[p.name] = options
+	return source, nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTokenFilterNoriPartOfSpeechSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		p           *TokenFilterNoriPartOfSpeech
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name.",
+			p:           NewTokenFilterNoriPartOfSpeech("test"),
+			includeName: true,
+			expected:    `{"test":{"type":"nori_part_of_speech"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with Stoptags.",
+			p:           NewTokenFilterNoriPartOfSpeech("test").Stoptags("NR", "SP"),
+			includeName: false,
+			expected:    `{"stoptags":["NR","SP"],"type":"nori_part_of_speech"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.p.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterNoriReadingform (Plugin) token filter that rewrites tokens written in Hanja to their
+// Hangul form.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-nori-readingform.html
+// for details.
+type TokenFilterNoriReadingform struct {
+	TokenFilter
+	name string
+
+	// fields specific to nori readingform token filter
+}
+
+// NewTokenFilterNoriReadingform initializes a new TokenFilterNoriReadingform.
+func NewTokenFilterNoriReadingform(name string) *TokenFilterNoriReadingform {
+	return &TokenFilterNoriReadingform{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (r *TokenFilterNoriReadingform) Name() string {
+	return r.name
+}
+
+// Validate validates TokenFilterNoriReadingform.
+func (r *TokenFilterNoriReadingform) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && r.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (r *TokenFilterNoriReadingform) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "nori_readingform"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "nori_readingform"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[r.name] = options
+	return source, nil
+}
@@ -54,6 +54,12 @@ func (c *TokenFilterPatternCapture) Validate(includeName bool) error {
 	if includeName && c.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	for _, pattern := range c.patterns {
+		if err := ValidateJavaRegex(pattern); err != nil {
+			invalid = append(invalid, "Patterns")
+			break
+		}
+	}
 	if len(invalid) > 0 {
 		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
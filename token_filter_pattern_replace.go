@@ -54,8 +54,11 @@ func (r *TokenFilterPatternReplace) Validate(includeName bool) error {
 	if includeName && r.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if err := ValidateJavaRegex(r.pattern); err != nil {
+		invalid = append(invalid, "Pattern")
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
@@ -0,0 +1,61 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterPersianNormalization token filter that normalizes Persian text.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-normalization-tokenfilter.html
+// for details.
+type TokenFilterPersianNormalization struct {
+	TokenFilter
+	name string
+
+	// fields specific to persian normalization token filter
+}
+
+// NewTokenFilterPersianNormalization initializes a new TokenFilterPersianNormalization.
+func NewTokenFilterPersianNormalization(name string) *TokenFilterPersianNormalization {
+	return &TokenFilterPersianNormalization{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (n *TokenFilterPersianNormalization) Name() string {
+	return n.name
+}
+
+// Validate validates TokenFilterPersianNormalization.
+func (n *TokenFilterPersianNormalization) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && n.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (n *TokenFilterPersianNormalization) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "persian_normalization"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "persian_normalization"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[n.name] = options
+	return source, nil
+}
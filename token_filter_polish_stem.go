@@ -0,0 +1,62 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterPolishStem (Plugin) token filter that provides Polish stemming using the Stempel
+// algorithmic stemmer.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-stempel.html
+// for details.
+type TokenFilterPolishStem struct {
+	TokenFilter
+	name string
+
+	// fields specific to polish stem token filter
+}
+
+// NewTokenFilterPolishStem initializes a new TokenFilterPolishStem.
+func NewTokenFilterPolishStem(name string) *TokenFilterPolishStem {
+	return &TokenFilterPolishStem{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (p *TokenFilterPolishStem) Name() string {
+	return p.name
+}
+
+// Validate validates TokenFilterPolishStem.
+func (p *TokenFilterPolishStem) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && p.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (p *TokenFilterPolishStem) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "polish_stem"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "polish_stem"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[p.name] = options
+	return source, nil
+}
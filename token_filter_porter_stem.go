@@ -0,0 +1,63 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterPorterStem token filter that provides algorithmic stemming for the English language,
+// based on the Porter stemming algorithm. It requires lowercase input. Tokens marked as keywords,
+// eg by the `keyword_marker` token filter, are not stemmed.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-porterstem-tokenfilter.html
+// for details.
+type TokenFilterPorterStem struct {
+	TokenFilter
+	name string
+
+	// fields specific to porter stem token filter
+}
+
+// NewTokenFilterPorterStem initializes a new TokenFilterPorterStem.
+func NewTokenFilterPorterStem(name string) *TokenFilterPorterStem {
+	return &TokenFilterPorterStem{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (p *TokenFilterPorterStem) Name() string {
+	return p.name
+}
+
+// Validate validates TokenFilterPorterStem.
+func (p *TokenFilterPorterStem) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && p.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (p *TokenFilterPorterStem) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "porter_stem"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "porter_stem"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[p.name] = options
+	return source, nil
+}
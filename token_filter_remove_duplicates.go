@@ -0,0 +1,61 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterRemoveDuplicates token filter that removes duplicate tokens in the same position.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-remove-duplicates-tokenfilter.html
+// for details.
+type TokenFilterRemoveDuplicates struct {
+	TokenFilter
+	name string
+
+	// fields specific to remove duplicates token filter
+}
+
+// NewTokenFilterRemoveDuplicates initializes a new TokenFilterRemoveDuplicates.
+func NewTokenFilterRemoveDuplicates(name string) *TokenFilterRemoveDuplicates {
+	return &TokenFilterRemoveDuplicates{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (d *TokenFilterRemoveDuplicates) Name() string {
+	return d.name
+}
+
+// Validate validates TokenFilterRemoveDuplicates.
+func (d *TokenFilterRemoveDuplicates) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && d.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (d *TokenFilterRemoveDuplicates) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "remove_duplicates"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "remove_duplicates"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[d.name] = options
+	return source, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterReverse token filter that reverses each token, eg "quick" => "kciuq".
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-reverse-tokenfilter.html
+// for details.
+type TokenFilterReverse struct {
+	TokenFilter
+	name string
+
+	// fields specific to reverse token filter
+}
+
+// NewTokenFilterReverse initializes a new TokenFilterReverse.
+func NewTokenFilterReverse(name string) *TokenFilterReverse {
+	return &TokenFilterReverse{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (r *TokenFilterReverse) Name() string {
+	return r.name
+}
+
+// Validate validates TokenFilterReverse.
+func (r *TokenFilterReverse) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && r.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (r *TokenFilterReverse) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "reverse"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "reverse"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[r.name] = options
+	return source, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterScandinavianFolding token filter that folds the Scandinavian characters åÅäæÄÆ => a and öÖøØ => o, and
+// removes the folded variants aa, ao, ae, oe and oo.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-normalization-tokenfilter.html
+// for details.
+type TokenFilterScandinavianFolding struct {
+	TokenFilter
+	name string
+
+	// fields specific to scandinavian folding token filter
+}
+
+// NewTokenFilterScandinavianFolding initializes a new TokenFilterScandinavianFolding.
+func NewTokenFilterScandinavianFolding(name string) *TokenFilterScandinavianFolding {
+	return &TokenFilterScandinavianFolding{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (f *TokenFilterScandinavianFolding) Name() string {
+	return f.name
+}
+
+// Validate validates TokenFilterScandinavianFolding.
+func (f *TokenFilterScandinavianFolding) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && f.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (f *TokenFilterScandinavianFolding) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "scandinavian_folding"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "scandinavian_folding"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[f.name] = options
+	return source, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterScandinavianNormalization token filter that normalizes the use of the interchangeable Scandinavian characters
+// æÆäÄöÖøØ and folded variants aa, ao, ae, oe and oo, leaving only åÅæÆøØ.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-normalization-tokenfilter.html
+// for details.
+type TokenFilterScandinavianNormalization struct {
+	TokenFilter
+	name string
+
+	// fields specific to scandinavian normalization token filter
+}
+
+// NewTokenFilterScandinavianNormalization initializes a new TokenFilterScandinavianNormalization.
+func NewTokenFilterScandinavianNormalization(name string) *TokenFilterScandinavianNormalization {
+	return &TokenFilterScandinavianNormalization{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (n *TokenFilterScandinavianNormalization) Name() string {
+	return n.name
+}
+
+// Validate validates TokenFilterScandinavianNormalization.
+func (n *TokenFilterScandinavianNormalization) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && n.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (n *TokenFilterScandinavianNormalization) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "scandinavian_normalization"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "scandinavian_normalization"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[n.name] = options
+	return source, nil
+}
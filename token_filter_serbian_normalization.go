@@ -0,0 +1,61 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterSerbianNormalization token filter that normalizes Serbian Cyrillic and Latin characters to Bald Latin.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-normalization-tokenfilter.html
+// for details.
+type TokenFilterSerbianNormalization struct {
+	TokenFilter
+	name string
+
+	// fields specific to serbian normalization token filter
+}
+
+// NewTokenFilterSerbianNormalization initializes a new TokenFilterSerbianNormalization.
+func NewTokenFilterSerbianNormalization(name string) *TokenFilterSerbianNormalization {
+	return &TokenFilterSerbianNormalization{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (n *TokenFilterSerbianNormalization) Name() string {
+	return n.name
+}
+
+// Validate validates TokenFilterSerbianNormalization.
+func (n *TokenFilterSerbianNormalization) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && n.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (n *TokenFilterSerbianNormalization) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "serbian_normalization"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "serbian_normalization"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[n.name] = options
+	return source, nil
+}
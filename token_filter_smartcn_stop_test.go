@@ -0,0 +1,49 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTokenFilterSmartcnStopSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		s           *TokenFilterSmartcnStop
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name with Stopwords.",
+			s:           NewTokenFilterSmartcnStop("test").Stopwords("_smartcn_"),
+			includeName: true,
+			expected:    `{"test":{"stopwords":"_smartcn_","type":"smartcn_stop"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with multiple Stopwords and StopwordsPath.",
+			s:           NewTokenFilterSmartcnStop("test").Stopwords("_smartcn_", "stack").StopwordsPath("stopwords_cn.txt"),
+			includeName: false,
+			expected:    `{"stopwords":["_smartcn_","stack"],"stopwords_path":"stopwords_cn.txt","type":"smartcn_stop"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.s.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
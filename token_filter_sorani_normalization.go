@@ -0,0 +1,61 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterSoraniNormalization token filter that normalizes Sorani text.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-normalization-tokenfilter.html
+// for details.
+type TokenFilterSoraniNormalization struct {
+	TokenFilter
+	name string
+
+	// fields specific to sorani normalization token filter
+}
+
+// NewTokenFilterSoraniNormalization initializes a new TokenFilterSoraniNormalization.
+func NewTokenFilterSoraniNormalization(name string) *TokenFilterSoraniNormalization {
+	return &TokenFilterSoraniNormalization{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (n *TokenFilterSoraniNormalization) Name() string {
+	return n.name
+}
+
+// Validate validates TokenFilterSoraniNormalization.
+func (n *TokenFilterSoraniNormalization) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && n.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (n *TokenFilterSoraniNormalization) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "sorani_normalization"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "sorani_normalization"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[n.name] = options
+	return source, nil
+}
@@ -45,6 +45,7 @@ func (s *TokenFilterStemmer) Name() string {
 // "danish" - Danish
 // "dutch" || "dutch_kp" - Dutch
 // "english" || "light_english" || "minimal_english" || "possessive_english" || "porter2" || "lovins" - English
+// "estonian" - Estonian
 // "finnish" || "light_finnish" - Finnish
 // "french" || "light_french" || "minimal_french" - French
 // "galician" || "minimal_galician" - Galician (Plural step only)
@@ -59,7 +60,7 @@ func (s *TokenFilterStemmer) Name() string {
 // "latvian" - Latvian
 // "lithuanian" - Lithuanian
 // "norwegian" || "light_norwegian" || "minimal_norwegian" - Norwegian (Bokmål)
-// "light_nyrorsk" || "minimal_nynorsk" - Norwegian (Nynorsk)
+// "light_nynorsk" || "minimal_nynorsk" - Norwegian (Nynorsk)
 // "portuguese" || "light_portuguese" || "minimal_portuguese" || "portuguese_rslp" - Portuguese
 // "romanian" - Romanian
 // "russian" || "light_russian" - Russian
@@ -97,6 +98,7 @@ func (s *TokenFilterStemmer) Validate(includeName bool) error {
 			"possessive_english": true,
 			"porter2":            true,
 			"lovins":             true,
+			"estonian":           true,
 			"finnish":            true,
 			"light_finnish":      true,
 			"french":             true,
@@ -122,8 +124,8 @@ func (s *TokenFilterStemmer) Validate(includeName bool) error {
 			"norwegian":          true,
 			"light_norwegian":    true,
 			"minimal_norwegian":  true,
-			"light_nyrorsk":      true,
-			"minimal_nyrorsk":    true,
+			"light_nynorsk":      true,
+			"minimal_nynorsk":    true,
 			"portuguese":         true,
 			"light_portuguese":   true,
 			"minimal_portuguese": true,
@@ -72,8 +72,11 @@ func (s *TokenFilterStop) Validate(includeName bool) error {
 	if includeName && s.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if err := ValidateStopwords(s.stopwords...); err != nil {
+		invalid = append(invalid, "Stopwords")
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
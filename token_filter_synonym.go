@@ -21,6 +21,7 @@ type TokenFilterSynonym struct {
 	synonymsPath string
 	expand       *bool
 	lenient      *bool
+	updateable   *bool
 	format       string
 	tokenizer    string
 	ignoreCase   *bool
@@ -77,6 +78,18 @@ func (s *TokenFilterSynonym) Lenient(lenient bool) *TokenFilterSynonym {
 	return s
 }
 
+// Updateable sets whether the synonyms can be reloaded with the `_reload_search_analyzers`
+// API without closing the index. Analyzers using an updateable filter can only be used as
+// search analyzers.
+// Defaults to false.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/indices-reload-analyzers.html
+// for details.
+func (s *TokenFilterSynonym) Updateable(updateable bool) *TokenFilterSynonym {
+	s.updateable = &updateable
+	return s
+}
+
 // Format sets synonym format to be used.
 // Can be set to the following values:
 // "solr"
@@ -130,6 +143,7 @@ func (s *TokenFilterSynonym) Source(includeName bool) (interface{}, error) {
 	// 		"synonyms_path": "analysis/synonym.txt",
 	// 		"expand": true,
 	// 		"lenient": true,
+	// 		"updateable": true,
 	// 		"format": "solr",
 	// 		"tokenizer": "standard",
 	// 		"ignore_case": true
@@ -183,6 +197,9 @@ func (s *TokenFilterSynonym) Source(includeName bool) (interface{}, error) {
 	if s.lenient != nil {
 		options["lenient"] = s.lenient
 	}
+	if s.updateable != nil {
+		options["updateable"] = s.updateable
+	}
 	if s.format != "" {
 		options["format"] = s.format
 	}
@@ -23,6 +23,7 @@ type TokenFilterSynonymGraph struct {
 	synonymsPath string
 	expand       *bool
 	lenient      *bool
+	updateable   *bool
 	format       string
 	tokenizer    string
 	ignoreCase   *bool
@@ -79,6 +80,18 @@ func (g *TokenFilterSynonymGraph) Lenient(lenient bool) *TokenFilterSynonymGraph
 	return g
 }
 
+// Updateable sets whether the synonyms can be reloaded with the `_reload_search_analyzers`
+// API without closing the index. Analyzers using an updateable filter can only be used as
+// search analyzers.
+// Defaults to false.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/indices-reload-analyzers.html
+// for details.
+func (g *TokenFilterSynonymGraph) Updateable(updateable bool) *TokenFilterSynonymGraph {
+	g.updateable = &updateable
+	return g
+}
+
 // Format sets synonym format to be used.
 // Can be set to the following values:
 // "solr"
@@ -132,6 +145,7 @@ func (g *TokenFilterSynonymGraph) Source(includeName bool) (interface{}, error)
 	// 		"synonyms_path": "analysis/synonym.txt",
 	// 		"expand": true,
 	// 		"lenient": true,
+	// 		"updateable": true,
 	// 		"format": "solr",
 	// 		"tokenizer": "standard",
 	// 		"ignore_case": true
@@ -185,6 +199,9 @@ func (g *TokenFilterSynonymGraph) Source(includeName bool) (interface{}, error)
 	if g.lenient != nil {
 		options["lenient"] = g.lenient
 	}
+	if g.updateable != nil {
+		options["updateable"] = g.updateable
+	}
 	if g.format != "" {
 		options["format"] = g.format
 	}
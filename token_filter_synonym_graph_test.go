@@ -37,6 +37,13 @@ func TestTokenFilterSynonymGraphSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"ignore_case":true,"tokenizer":"standard","type":"synonym_graph"}`,
 		},
+		// #3
+		{
+			desc:        "Exclude Name with Updateable and SynonymsPath.",
+			s:           NewTokenFilterSynonymGraph("test").Updateable(true).SynonymsPath("analysis/synonym.txt"),
+			includeName: false,
+			expected:    `{"synonyms_path":"analysis/synonym.txt","type":"synonym_graph","updateable":true}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
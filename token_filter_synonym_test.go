@@ -37,6 +37,13 @@ func TestTokenFilterSynonymSerialization(t *testing.T) {
 			includeName: false,
 			expected:    `{"ignore_case":true,"tokenizer":"standard","type":"synonym"}`,
 		},
+		// #3
+		{
+			desc:        "Exclude Name with Updateable and SynonymsPath.",
+			s:           NewTokenFilterSynonym("test").Updateable(true).SynonymsPath("analysis/synonym.txt"),
+			includeName: false,
+			expected:    `{"synonyms_path":"analysis/synonym.txt","type":"synonym","updateable":true}`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -0,0 +1,61 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterTrim token filter that removes leading and trailing whitespace from each token.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-trim-tokenfilter.html
+// for details.
+type TokenFilterTrim struct {
+	TokenFilter
+	name string
+
+	// fields specific to trim token filter
+}
+
+// NewTokenFilterTrim initializes a new TokenFilterTrim.
+func NewTokenFilterTrim(name string) *TokenFilterTrim {
+	return &TokenFilterTrim{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (t *TokenFilterTrim) Name() string {
+	return t.name
+}
+
+// Validate validates TokenFilterTrim.
+func (t *TokenFilterTrim) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && t.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (t *TokenFilterTrim) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "trim"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "trim"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[t.name] = options
+	return source, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenFilterUppercase token filter that changes token text to uppercase.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.5/analysis-uppercase-tokenfilter.html
+// for details.
+type TokenFilterUppercase struct {
+	TokenFilter
+	name string
+
+	// fields specific to uppercase token filter
+}
+
+// NewTokenFilterUppercase initializes a new TokenFilterUppercase.
+func NewTokenFilterUppercase(name string) *TokenFilterUppercase {
+	return &TokenFilterUppercase{
+		name: name,
+	}
+}
+
+// Name returns field key for the Token Filter.
+func (u *TokenFilterUppercase) Name() string {
+	return u.name
+}
+
+// Validate validates TokenFilterUppercase.
+func (u *TokenFilterUppercase) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && u.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (u *TokenFilterUppercase) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "uppercase"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "uppercase"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[u.name] = options
+	return source, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TokenizerICU (Plugin) Word Orientated Tokenizer that tokenizes text into words on word boundaries,
+// as defined in UAX #29: Unicode Text Segmentation. It behaves much like the standard tokenizer, but
+// adds better support for some Asian languages by using a dictionary-based approach to identify words
+// in Thai, Lao, Chinese, Japanese, and Korean, and using custom rules to break Myanmar and Khmer text
+// into syllables.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-icu-tokenizer.html
+// for details.
+type TokenizerICU struct {
+	Tokenizer
+	name string
+
+	// fields specific to icu tokenizer
+	ruleFiles []string
+}
+
+// NewTokenizerICU initializes a new TokenizerICU.
+func NewTokenizerICU(name string) *TokenizerICU {
+	return &TokenizerICU{
+		name:      name,
+		ruleFiles: make([]string, 0),
+	}
+}
+
+// Name returns field key for the Tokenizer.
+func (i *TokenizerICU) Name() string {
+	return i.name
+}
+
+// RuleFiles sets the custom rule files for the tokenizer, in the form of "code:rulefile", where
+// "code" is a four-letter ISO 15924 script code and "rulefile" is a RBBI rule file located in the
+// Elasticsearch `config` directory. eg "Latn:KeywordTokenizer.rbbi".
+func (i *TokenizerICU) RuleFiles(ruleFiles ...string) *TokenizerICU {
+	i.ruleFiles = append(i.ruleFiles, ruleFiles...)
+	return i
+}
+
+// Validate validates TokenizerICU.
+func (i *TokenizerICU) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && i.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	for _, ruleFile := range i.ruleFiles {
+		parts := strings.Split(ruleFile, ":")
+		if len(parts) != 2 || len(parts[0]) != 4 || parts[1] == "" {
+			invalid = append(invalid, "RuleFiles")
+			break
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (i *TokenizerICU) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "icu_tokenizer",
+	// 		"rule_files": "Latn:KeywordTokenizer.rbbi"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "icu_tokenizer"
+
+	if len(i.ruleFiles) > 0 {
+		options["rule_files"] = strings.Join(i.ruleFiles, ",")
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[i.name] = options
+	return source, nil
+}
@@ -0,0 +1,204 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strings"
+)
+
+// TokenizerKuromoji (Plugin) Word Orientated Tokenizer that tokenizes Japanese text using
+// morphological analysis.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-kuromoji-tokenizer.html
+// for details.
+type TokenizerKuromoji struct {
+	Tokenizer
+	name string
+
+	// fields specific to kuromoji tokenizer
+	mode                string
+	discardPunctuation  *bool
+	userDictionary      string
+	userDictionaryRules []string
+	nbestCost           *int
+	nbestExamples       string
+}
+
+// NewTokenizerKuromoji initializes a new TokenizerKuromoji.
+func NewTokenizerKuromoji(name string) *TokenizerKuromoji {
+	return &TokenizerKuromoji{
+		name:                name,
+		userDictionaryRules: make([]string, 0),
+	}
+}
+
+// Name returns field key for the Tokenizer.
+func (k *TokenizerKuromoji) Name() string {
+	return k.name
+}
+
+// Mode sets the tokenization mode which determines how the tokenizer handles compound and unknown words.
+// Can be set to the following values:
+// "normal" - Normal segmentation, no decomposition for compounds.
+// "search" - Segmentation geared towards search. This includes a decompounding process for long
+// nouns, also including the full compound token as a synonym.
+// "extended" - Extended mode outputs unigrams for unknown words.
+// Defaults to "search".
+func (k *TokenizerKuromoji) Mode(mode string) *TokenizerKuromoji {
+	k.mode = mode
+	return k
+}
+
+// DiscardPunctuation sets whether punctuation should be discarded from the output.
+// Defaults to true.
+func (k *TokenizerKuromoji) DiscardPunctuation(discardPunctuation bool) *TokenizerKuromoji {
+	k.discardPunctuation = &discardPunctuation
+	return k
+}
+
+// UserDictionary sets the path to a MeCab user dictionary file in CSV format, relative to the
+// Elasticsearch `config` directory. Cannot be used together with `user_dictionary_rules`.
+func (k *TokenizerKuromoji) UserDictionary(userDictionary string) *TokenizerKuromoji {
+	k.userDictionary = userDictionary
+	return k
+}
+
+// UserDictionaryRules sets the user dictionary rules inline, in the same CSV format as the
+// user dictionary file, eg "東京スカイツリー,東京 スカイツリー,トウキョウ スカイツリー,カスタム名詞".
+// Cannot be used together with `user_dictionary`.
+func (k *TokenizerKuromoji) UserDictionaryRules(userDictionaryRules ...string) *TokenizerKuromoji {
+	k.userDictionaryRules = append(k.userDictionaryRules, userDictionaryRules...)
+	return k
+}
+
+// NbestCost sets the cost used to include additional segmentation candidates (n-best) in the
+// output, which improves recall for ambiguous segmentations.
+func (k *TokenizerKuromoji) NbestCost(nbestCost int) *TokenizerKuromoji {
+	k.nbestCost = &nbestCost
+	return k
+}
+
+// NbestExamples sets examples used to compute the `nbest_cost` automatically, eg
+// "/箱根山-箱根/成田空港-成田/".
+func (k *TokenizerKuromoji) NbestExamples(nbestExamples string) *TokenizerKuromoji {
+	k.nbestExamples = nbestExamples
+	return k
+}
+
+// Validate validates TokenizerKuromoji.
+func (k *TokenizerKuromoji) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && k.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if k.mode != "" {
+		if _, valid := map[string]bool{
+			"normal":   true,
+			"search":   true,
+			"extended": true,
+		}[k.mode]; !valid {
+			invalid = append(invalid, "Mode")
+		}
+	}
+	if k.userDictionary != "" && len(k.userDictionaryRules) > 0 {
+		invalid = append(invalid, "UserDictionary")
+	}
+	if err := ValidateKuromojiUserDictionaryRules(k.userDictionaryRules...); err != nil {
+		invalid = append(invalid, "UserDictionaryRules")
+	}
+	if k.nbestCost != nil && *k.nbestCost < 0 {
+		invalid = append(invalid, "NbestCost")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// ValidateKuromojiUserDictionaryRules validates user dictionary rules against the MeCab CSV
+// format "<text>,<token 1> ... <token n>,<reading 1> ... <reading n>,<part-of-speech tag>".
+// The tokens must concatenate back to the text and there must be exactly one reading per token.
+// Empty rules and comments starting with "#" are ignored, duplicated texts are rejected.
+func ValidateKuromojiUserDictionaryRules(rules ...string) error {
+	var invalid []string
+	seen := make(map[string]bool)
+	for i, rule := range rules {
+		if strings.TrimSpace(rule) == "" || strings.HasPrefix(strings.TrimSpace(rule), "#") {
+			continue
+		}
+		reader := csv.NewReader(strings.NewReader(rule))
+		reader.FieldsPerRecord = -1
+		records, err := reader.ReadAll()
+		if err != nil || len(records) != 1 {
+			invalid = append(invalid, fmt.Sprintf("#%d: malformed CSV", i))
+			continue
+		}
+		fields := records[0]
+		if len(fields) != 4 {
+			invalid = append(invalid, fmt.Sprintf("#%d: expected 4 fields, got %d", i, len(fields)))
+			continue
+		}
+		text, segmentation, readings := fields[0], strings.Fields(fields[1]), strings.Fields(fields[2])
+		switch {
+		case text == "" || fields[3] == "":
+			invalid = append(invalid, fmt.Sprintf("#%d: empty text or part-of-speech tag", i))
+		case strings.Join(segmentation, "") != text:
+			invalid = append(invalid, fmt.Sprintf("#%d: segmentation does not match text %q", i, text))
+		case len(segmentation) != len(readings):
+			invalid = append(invalid, fmt.Sprintf("#%d: %d segments but %d readings", i, len(segmentation), len(readings)))
+		case seen[text]:
+			invalid = append(invalid, fmt.Sprintf("#%d: duplicated text %q", i, text))
+		}
+		seen[text] = true
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid user dictionary rules: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (k *TokenizerKuromoji) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "kuromoji_tokenizer",
+	// 		"mode": "extended",
+	// 		"discard_punctuation": false,
+	// 		"user_dictionary_rules": ["東京スカイツリー,東京 スカイツリー,トウキョウ スカイツリー,カスタム名詞"],
+	// 		"nbest_cost": 1000
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "kuromoji_tokenizer"
+
+	if k.mode != "" {
+		options["mode"] = k.mode
+	}
+	if k.discardPunctuation != nil {
+		options["discard_punctuation"] = k.discardPunctuation
+	}
+	if k.userDictionary != "" {
+		options["user_dictionary"] = k.userDictionary
+	}
+	if len(k.userDictionaryRules) > 0 {
+		options["user_dictionary_rules"] = k.userDictionaryRules
+	}
+	if k.nbestCost != nil {
+		options["nbest_cost"] = k.nbestCost
+	}
+	if k.nbestExamples != "" {
+		options["nbest_examples"] = k.nbestExamples
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[k.name] = options
+	return source, nil
+}
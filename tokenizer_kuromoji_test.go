@@ -0,0 +1,109 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTokenizerKuromojiSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		k           *TokenizerKuromoji
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name with Mode and DiscardPunctuation.",
+			k:           NewTokenizerKuromoji("test").Mode("extended").DiscardPunctuation(false),
+			includeName: true,
+			expected:    `{"test":{"discard_punctuation":false,"mode":"extended","type":"kuromoji_tokenizer"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with UserDictionaryRules and NbestCost.",
+			k:           NewTokenizerKuromoji("test").UserDictionaryRules("東京スカイツリー,東京 スカイツリー,トウキョウ スカイツリー,カスタム名詞").NbestCost(1000),
+			includeName: false,
+			expected:    `{"nbest_cost":1000,"type":"kuromoji_tokenizer","user_dictionary_rules":["東京スカイツリー,東京 スカイツリー,トウキョウ スカイツリー,カスタム名詞"]}`,
+		},
+		// #2
+		{
+			desc:        "Exclude Name with UserDictionary and NbestExamples.",
+			k:           NewTokenizerKuromoji("test").UserDictionary("userdict_ja.txt").NbestExamples("/箱根山-箱根/成田空港-成田/"),
+			includeName: false,
+			expected:    `{"nbest_examples":"/箱根山-箱根/成田空港-成田/","type":"kuromoji_tokenizer","user_dictionary":"userdict_ja.txt"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.k.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateKuromojiUserDictionaryRules(t *testing.T) {
+	tests := []struct {
+		desc  string
+		rules []string
+		valid bool
+	}{
+		// #0
+		{
+			desc:  "Valid rules with comment.",
+			rules: []string{"# custom nouns", "東京スカイツリー,東京 スカイツリー,トウキョウ スカイツリー,カスタム名詞", "関西国際空港,関西 国際 空港,カンサイ コクサイ クウコウ,カスタム名詞"},
+			valid: true,
+		},
+		// #1
+		{
+			desc:  "Missing part-of-speech field.",
+			rules: []string{"東京スカイツリー,東京 スカイツリー,トウキョウ スカイツリー"},
+			valid: false,
+		},
+		// #2
+		{
+			desc:  "Segmentation does not match text.",
+			rules: []string{"東京スカイツリー,東京 タワー,トウキョウ タワー,カスタム名詞"},
+			valid: false,
+		},
+		// #3
+		{
+			desc:  "Readings count does not match segments count.",
+			rules: []string{"東京スカイツリー,東京 スカイツリー,トウキョウスカイツリー,カスタム名詞"},
+			valid: false,
+		},
+		// #4
+		{
+			desc:  "Duplicated text.",
+			rules: []string{"東京,東京,トウキョウ,カスタム名詞", "東京,東京,トウキョウ,カスタム名詞"},
+			valid: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := ValidateKuromojiUserDictionaryRules(test.rules...)
+			if test.valid && err != nil {
+				t.Errorf("expected valid, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+	if err := NewTokenizerKuromoji("test").UserDictionary("userdict_ja.txt").UserDictionaryRules("東京,東京,トウキョウ,カスタム名詞").Validate(true); err == nil {
+		t.Error("expected TokenizerKuromoji validation error, got nil")
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TokenizerNori (Plugin) Word Orientated Tokenizer that tokenizes Korean text using
+// morphological analysis.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-nori-tokenizer.html
+// for details.
+type TokenizerNori struct {
+	Tokenizer
+	name string
+
+	// fields specific to nori tokenizer
+	decompoundMode      string
+	userDictionary      string
+	userDictionaryRules []string
+}
+
+// NewTokenizerNori initializes a new TokenizerNori.
+func NewTokenizerNori(name string) *TokenizerNori {
+	return &TokenizerNori{
+		name:                name,
+		userDictionaryRules: make([]string, 0),
+	}
+}
+
+// Name returns field key for the Tokenizer.
+func (n *TokenizerNori) Name() string {
+	return n.name
+}
+
+// DecompoundMode sets how the tokenizer handles compound tokens.
+// Can be set to the following values:
+// "none" - No decomposition for compounds, eg "가거도항" => "가거도항".
+// "discard" - Decomposes compounds and discards the original form, eg "가거도항" => "가거도", "항".
+// "mixed" - Decomposes compounds and keeps the original form, eg "가거도항" => "가거도항", "가거도", "항".
+// Defaults to "discard".
+func (n *TokenizerNori) DecompoundMode(decompoundMode string) *TokenizerNori {
+	n.decompoundMode = decompoundMode
+	return n
+}
+
+// UserDictionary sets the path to a user dictionary file, relative to the Elasticsearch `config`
+// directory. Cannot be used together with `user_dictionary_rules`.
+func (n *TokenizerNori) UserDictionary(userDictionary string) *TokenizerNori {
+	n.userDictionary = userDictionary
+	return n
+}
+
+// UserDictionaryRules sets the user dictionary rules inline, in the same format as the user
+// dictionary file, ie a noun optionally followed by its segmentation, eg "세종시 세종 시".
+// Cannot be used together with `user_dictionary`.
+func (n *TokenizerNori) UserDictionaryRules(userDictionaryRules ...string) *TokenizerNori {
+	n.userDictionaryRules = append(n.userDictionaryRules, userDictionaryRules...)
+	return n
+}
+
+// Validate validates TokenizerNori.
+func (n *TokenizerNori) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && n.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if n.decompoundMode != "" {
+		if _, valid := map[string]bool{
+			"none":    true,
+			"discard": true,
+			"mixed":   true,
+		}[n.decompoundMode]; !valid {
+			invalid = append(invalid, "DecompoundMode")
+		}
+	}
+	if n.userDictionary != "" && len(n.userDictionaryRules) > 0 {
+		invalid = append(invalid, "UserDictionary")
+	}
+	if err := ValidateNoriUserDictionaryRules(n.userDictionaryRules...); err != nil {
+		invalid = append(invalid, "UserDictionaryRules")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
+	}
+	return nil
+}
+
+// ValidateNoriUserDictionaryRules validates user dictionary rules against the format
+// "<noun> [<segment 1> ... <segment n>]". When a segmentation is given, the segments must
+// concatenate back to the noun. Empty rules and comments starting with "#" are ignored.
+func ValidateNoriUserDictionaryRules(rules ...string) error {
+	var invalid []string
+	for i, rule := range rules {
+		if strings.TrimSpace(rule) == "" || strings.HasPrefix(strings.TrimSpace(rule), "#") {
+			continue
+		}
+		fields := strings.Fields(rule)
+		if len(fields) > 1 && strings.Join(fields[1:], "") != fields[0] {
+			invalid = append(invalid, fmt.Sprintf("#%d: segmentation does not match noun %q", i, fields[0]))
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid user dictionary rules: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (n *TokenizerNori) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "nori_tokenizer",
+	// 		"decompound_mode": "mixed",
+	// 		"user_dictionary_rules": ["c++", "C샤프", "세종", "세종시 세종 시"]
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "nori_tokenizer"
+
+	if n.decompoundMode != "" {
+		options["decompound_mode"] = n.decompoundMode
+	}
+	if n.userDictionary != "" {
+		options["user_dictionary"] = n.userDictionary
+	}
+	if len(n.userDictionaryRules) > 0 {
+		options["user_dictionary_rules"] = n.userDictionaryRules
+	}
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[n.name] = options
+	return source, nil
+}
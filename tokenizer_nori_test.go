@@ -0,0 +1,91 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTokenizerNoriSerialization(t *testing.T) {
+	tests := []struct {
+		desc        string
+		n           *TokenizerNori
+		includeName bool
+		expected    string
+	}{
+		// #0
+		{
+			desc:        "Include Name with DecompoundMode.",
+			n:           NewTokenizerNori("test").DecompoundMode("mixed"),
+			includeName: true,
+			expected:    `{"test":{"decompound_mode":"mixed","type":"nori_tokenizer"}}`,
+		},
+		// #1
+		{
+			desc:        "Exclude Name with UserDictionaryRules.",
+			n:           NewTokenizerNori("test").UserDictionaryRules("c++", "C샤프", "세종", "세종시 세종 시"),
+			includeName: false,
+			expected:    `{"type":"nori_tokenizer","user_dictionary_rules":["c++","C샤프","세종","세종시 세종 시"]}`,
+		},
+		// #2
+		{
+			desc:        "Exclude Name with UserDictionary.",
+			n:           NewTokenizerNori("test").UserDictionary("userdict_ko.txt"),
+			includeName: false,
+			expected:    `{"type":"nori_tokenizer","user_dictionary":"userdict_ko.txt"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			src, err := test.n.Source(test.includeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(src)
+			if err != nil {
+				t.Fatalf("marshaling to JSON failed: %v", err)
+			}
+			if got, expected := string(data), test.expected; got != expected {
+				t.Errorf("expected\n%s\n,got:\n%s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateNoriUserDictionaryRules(t *testing.T) {
+	tests := []struct {
+		desc  string
+		rules []string
+		valid bool
+	}{
+		// #0
+		{
+			desc:  "Valid rules with comment.",
+			rules: []string{"# custom nouns", "c++", "C샤프", "세종시 세종 시"},
+			valid: true,
+		},
+		// #1
+		{
+			desc:  "Segmentation does not match noun.",
+			rules: []string{"세종시 세종 도"},
+			valid: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := ValidateNoriUserDictionaryRules(test.rules...)
+			if test.valid && err != nil {
+				t.Errorf("expected valid, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+	if err := NewTokenizerNori("test").DecompoundMode("all").Validate(true); err == nil {
+		t.Error("expected TokenizerNori validation error, got nil")
+	}
+}
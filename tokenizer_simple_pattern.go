@@ -49,8 +49,11 @@ func (p *TokenizerSimplePattern) Validate(includeName bool) error {
 	if includeName && p.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if err := ValidateLuceneRegex(p.pattern); err != nil {
+		invalid = append(invalid, "Pattern")
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
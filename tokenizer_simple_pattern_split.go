@@ -48,8 +48,11 @@ func (s *TokenizerSimplePatternSplit) Validate(includeName bool) error {
 	if includeName && s.name == "" {
 		invalid = append(invalid, "Name")
 	}
+	if err := ValidateLuceneRegex(s.pattern); err != nil {
+		invalid = append(invalid, "Pattern")
+	}
 	if len(invalid) > 0 {
-		return fmt.Errorf("missing required fields: %v", invalid)
+		return fmt.Errorf("missing required fields or invalid values: %v", invalid)
 	}
 	return nil
 }
@@ -0,0 +1,62 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import "fmt"
+
+// TokenizerSmartcn (Plugin) Word Orientated Tokenizer that segments Simplified Chinese text into
+// words, using probabilistic knowledge to find the optimal word segmentation.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/plugins/7.5/analysis-smartcn.html
+// for details.
+type TokenizerSmartcn struct {
+	Tokenizer
+	name string
+
+	// fields specific to smartcn tokenizer
+}
+
+// NewTokenizerSmartcn initializes a new TokenizerSmartcn.
+func NewTokenizerSmartcn(name string) *TokenizerSmartcn {
+	return &TokenizerSmartcn{
+		name: name,
+	}
+}
+
+// Name returns field key for the Tokenizer.
+func (s *TokenizerSmartcn) Name() string {
+	return s.name
+}
+
+// Validate validates TokenizerSmartcn.
+func (s *TokenizerSmartcn) Validate(includeName bool) error {
+	var invalid []string
+	if includeName && s.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the serializable JSON for the source builder.
+func (s *TokenizerSmartcn) Source(includeName bool) (interface{}, error) {
+	// {
+	// 	"test": {
+	// 		"type": "smartcn_tokenizer"
+	// 	}
+	// }
+	options := make(map[string]interface{})
+	options["type"] = "smartcn_tokenizer"
+
+	if !includeName {
+		return options, nil
+	}
+
+	source := make(map[string]interface{})
+	source[s.name] = options
+	return source, nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// esVersion Elasticsearch version as major, minor and patch numbers.
+type esVersion [3]int
+
+// parseVersion parses an Elasticsearch version like "7.10.2" or "7.10", ignoring qualifiers
+// like "-SNAPSHOT".
+func parseVersion(v string) (esVersion, error) {
+	var parsed esVersion
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	parts := strings.Split(v, ".")
+	if len(parts) > 3 {
+		return parsed, fmt.Errorf("invalid version [%s]", v)
+	}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return parsed, fmt.Errorf("invalid version [%s]", v)
+		}
+		parsed[i] = n
+	}
+	return parsed, nil
+}
+
+// less returns whether v is older than o.
+func (v esVersion) less(o esVersion) bool {
+	for i := range v {
+		if v[i] != o[i] {
+			return v[i] < o[i]
+		}
+	}
+	return false
+}
+
+// versionedDatatype datatype which is only available since a given Elasticsearch version.
+type versionedDatatype interface {
+	MinimumVersion() string
+}
+
+// ValidateVersion validates that the mappings only use datatypes and mapping parameters which
+// are available in the given Elasticsearch version, eg "7.9.0", such as the `wildcard`
+// datatype which requires Elasticsearch 7.9, and that dense vectors do not exceed the maximum
+// number of dimensions of that version, and are explicitly indexed when using kNN options
+// before Elasticsearch 8.11.
+func (m *Mappings) ValidateVersion(version string) error {
+	current, err := parseVersion(version)
+	if err != nil {
+		return err
+	}
+	var invalid []string
+	check := func(component, minimum string) {
+		required, err := parseVersion(minimum)
+		if err != nil || !current.less(required) {
+			return
+		}
+		invalid = append(invalid, fmt.Sprintf("%s requires Elasticsearch %s or later", component, minimum))
+	}
+	dynamic := func(component string, mode DynamicMode) {
+		if mode == DynamicModeRuntime {
+			check(component+": dynamic [runtime]", "7.11.0")
+		}
+	}
+	subobjects := func(component string, subobjects *bool) {
+		if subobjects != nil {
+			check(component+": subobjects", "8.3.0")
+		}
+	}
+
+	dynamic("mappings", m.dynamic)
+	subobjects("mappings", m.subobjects)
+	if m.dataStreamTimestamp != nil {
+		check("meta field [_data_stream_timestamp]", "7.9.0")
+	}
+	for _, f := range m.runtime {
+		check("runtime field ["+f.name+"]", "7.11.0")
+		if f.typ == "composite" {
+			check("runtime field ["+f.name+"]: composite", "8.2.0")
+		}
+	}
+	walkDatatypes("", m.properties, func(path string, d Datatype) {
+		component := "field [" + path + "]"
+		if v, ok := d.(versionedDatatype); ok {
+			check(component, v.MinimumVersion())
+		}
+		switch t := d.(type) {
+		case *DatatypeObject:
+			dynamic(component, resolveDynamicMode(t.dynamic, t.strict, t.dynamicMode))
+			subobjects(component, t.subobjects)
+		case *DatatypeNested:
+			dynamic(component, resolveDynamicMode(t.dynamic, t.strict, t.dynamicMode))
+		case *DatatypeDenseVector:
+			maxDims := denseVectorMaxDims(current, t.index != nil && *t.index)
+			if t.dims != nil && *t.dims > maxDims {
+				invalid = append(invalid, fmt.Sprintf("%s: dims [%d] exceeds the maximum of %d dimensions of "+
+					"Elasticsearch %s", component, *t.dims, maxDims, version))
+			}
+			if t.index == nil && (t.similarity != "" || t.indexOptions != nil) && current.less(esVersion{8, 11, 0}) {
+				invalid = append(invalid, fmt.Sprintf("%s: similarity and index_options require index [true] "+
+					"before Elasticsearch 8.11.0", component))
+			}
+		}
+	})
+
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid mappings for Elasticsearch %s: %v", version, invalid)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) KwanJunWen
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package estemplate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMappingsValidateVersion(t *testing.T) {
+	tests := []struct {
+		desc     string
+		m        *Mappings
+		version  string
+		expected []string
+	}{
+		// #0
+		{
+			desc:     "Supported datatypes.",
+			m:        NewMappings().Properties(NewDatatypeWildcard("message"), NewDatatypeConstantKeyword("level")),
+			version:  "7.9.0",
+			expected: nil,
+		},
+		// #1
+		{
+			desc: "Unsupported datatypes.",
+			m: NewMappings().Properties(
+				NewDatatypeWildcard("message"),
+				NewDatatypeObject("build").Properties(NewDatatypeVersion("version")),
+				NewDatatypeKeyword("title").Fields(NewDatatypeMatchOnlyText("text")),
+			),
+			version: "7.9.3-SNAPSHOT",
+			expected: []string{
+				"field [build.version] requires Elasticsearch 7.10.0 or later",
+				"field [title.text] requires Elasticsearch 7.14.0 or later",
+			},
+		},
+		// #2
+		{
+			desc: "Unsupported dynamic mode and subobjects.",
+			m: NewMappings().Dynamic(DynamicModeRuntime).Properties(
+				NewDatatypeObject("metrics").Subobjects(false),
+			),
+			version: "7.10",
+			expected: []string{
+				"mappings: dynamic [runtime] requires Elasticsearch 7.11.0 or later",
+				"field [metrics]: subobjects requires Elasticsearch 8.3.0 or later",
+			},
+		},
+		// #3
+		{
+			desc: "Unsupported dense vector options.",
+			m: NewMappings().Properties(
+				NewDatatypeDenseVector("embedding").Dims(3).Index(true).IndexOptions(NewDenseVectorIndexOptions("int8_hnsw")),
+			),
+			version: "8.11.4",
+			expected: []string{
+				"field [embedding] requires Elasticsearch 8.12.0 or later",
+			},
+		},
+		// #4
+		{
+			desc: "Dense vector dims.",
+			m: NewMappings().Properties(
+				NewDatatypeDenseVector("small").Dims(1024),
+				NewDatatypeDenseVector("medium").Dims(2048),
+			),
+			version: "7.5.2",
+			expected: []string{
+				"field [medium]: dims [2048] exceeds the maximum of 1024 dimensions of Elasticsearch 7.5.2",
+			},
+		},
+		// #5
+		{
+			desc: "Indexed dense vector dims.",
+			m: NewMappings().Properties(
+				NewDatatypeDenseVector("stored").Dims(2048),
+				NewDatatypeDenseVector("indexed").Dims(2048).Index(true),
+				NewDatatypeDenseVector("large").Dims(4096),
+			),
+			version: "8.7.1",
+			expected: []string{
+				"field [indexed]: dims [2048] exceeds the maximum of 1024 dimensions of Elasticsearch 8.7.1",
+				"field [large]: dims [4096] exceeds the maximum of 2048 dimensions of Elasticsearch 8.7.1",
+			},
+		},
+		// #6
+		{
+			desc:     "Supported dense vector dims.",
+			m:        NewMappings().Properties(NewDatatypeDenseVector("embedding").Dims(4096).Index(true)),
+			version:  "8.10.0",
+			expected: nil,
+		},
+		// #7
+		{
+			desc: "Dense vector similarity indexed by default.",
+			m: NewMappings().Properties(
+				NewDatatypeDenseVector("default").Dims(3).Similarity("cosine"),
+				NewDatatypeDenseVector("indexed").Dims(3).Index(true).Similarity("cosine"),
+			),
+			version: "8.10.4",
+			expected: []string{
+				"field [default]: similarity and index_options require index [true] before Elasticsearch 8.11.0",
+			},
+		},
+		// #8
+		{
+			desc:     "Dense vector similarity indexed by default on current versions.",
+			m:        NewMappings().Properties(NewDatatypeDenseVector("default").Dims(3).Similarity("cosine")),
+			version:  "8.11.0",
+			expected: nil,
+		},
+		// #9
+		{
+			desc: "Unsupported runtime fields.",
+			m: NewMappings().Runtime(
+				NewRuntimeField("http", "composite").Script(NewScript("emit(params._source.http)")).Fields(NewRuntimeField("clientip", "ip")),
+			),
+			version: "8.1.0",
+			expected: []string{
+				"runtime field [http]: composite requires Elasticsearch 8.2.0 or later",
+			},
+		},
+	}
+	for i, test := range tests {
+		err := test.m.ValidateVersion(test.version)
+		if len(test.expected) == 0 {
+			if err != nil {
+				t.Errorf("#%d: expected no error, got: %v", i, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("#%d: expected error, got nil", i)
+			continue
+		}
+		for _, expected := range test.expected {
+			if !strings.Contains(err.Error(), expected) {
+				t.Errorf("#%d: expected error to contain %q, got: %v", i, expected, err)
+			}
+		}
+	}
+	if err := NewMappings().ValidateVersion("seven"); err == nil {
+		t.Error("expected invalid version error, got nil")
+	}
+}